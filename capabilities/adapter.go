@@ -0,0 +1,79 @@
+package capabilities
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+)
+
+type adapter struct {
+	builder           Builder
+	capabilityBuilder CapabilityBuilder
+}
+
+func createAdapter(
+	builder Builder,
+	capabilityBuilder CapabilityBuilder,
+) Adapter {
+	out := adapter{
+		builder:           builder,
+		capabilityBuilder: capabilityBuilder,
+	}
+
+	return &out
+}
+
+// ToCapabilities converts a manifest to capabilities
+func (app *adapter) ToCapabilities(manifest []byte) (Capabilities, error) {
+	entries := strings.FieldsFunc(string(manifest), func(r rune) bool {
+		return r == ' ' || r == ',' || r == '\t' || r == '\n' || r == '\r'
+	})
+
+	list := []Capability{}
+	for _, oneEntry := range entries {
+		builder := app.capabilityBuilder.Create()
+		sections := strings.SplitN(oneEntry, pathDelimiter, 2)
+		builder.WithName(sections[0])
+		if len(sections) > 1 {
+			builder.WithPath(sections[1])
+		}
+
+		ins, err := builder.Now()
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, ins)
+	}
+
+	return app.builder.Create().WithList(list).Now()
+}
+
+// FromScriptHeader returns the capabilities declared in the leading comments of a script, if any
+func (app *adapter) FromScriptHeader(script []byte) (Capabilities, bool, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(script))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		if !strings.HasPrefix(line, headerPrefix) {
+			break
+		}
+
+		comment := strings.TrimSpace(strings.TrimPrefix(line, headerPrefix))
+		if !strings.HasPrefix(comment, headerKeyword) {
+			continue
+		}
+
+		ins, err := app.ToCapabilities([]byte(strings.TrimPrefix(comment, headerKeyword)))
+		if err != nil {
+			return nil, false, err
+		}
+
+		return ins, true, nil
+	}
+
+	return nil, false, nil
+}
@@ -0,0 +1,49 @@
+package capabilities
+
+import (
+	"errors"
+	"fmt"
+)
+
+type builder struct {
+	list []Capability
+}
+
+func createBuilder() Builder {
+	out := builder{
+		list: nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *builder) Create() Builder {
+	return createBuilder()
+}
+
+// WithList adds a list to the builder
+func (app *builder) WithList(list []Capability) Builder {
+	app.list = list
+	return app
+}
+
+// Now builds a new Capabilities instance
+func (app *builder) Now() (Capabilities, error) {
+	if app.list == nil {
+		app.list = []Capability{}
+	}
+
+	mp := map[string]Capability{}
+	for _, oneCapability := range app.list {
+		name := oneCapability.Name()
+		if _, ok := mp[name]; ok {
+			str := fmt.Sprintf("the capability (name: %s) is declared more than once", name)
+			return nil, errors.New(str)
+		}
+
+		mp[name] = oneCapability
+	}
+
+	return createCapabilities(app.list, mp), nil
+}
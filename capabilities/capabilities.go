@@ -0,0 +1,93 @@
+package capabilities
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type capabilities struct {
+	list []Capability
+	mp   map[string]Capability
+}
+
+func createCapabilities(
+	list []Capability,
+	mp map[string]Capability,
+) Capabilities {
+	out := capabilities{
+		list: list,
+		mp:   mp,
+	}
+
+	return &out
+}
+
+// List returns the capabilities
+func (obj *capabilities) List() []Capability {
+	return obj.list
+}
+
+// Fetch fetches a capability by name
+func (obj *capabilities) Fetch(name string) (Capability, error) {
+	if ins, ok := obj.mp[name]; ok {
+		return ins, nil
+	}
+
+	str := fmt.Sprintf("the capability (name: %s) is not granted", name)
+	return nil, errors.New(str)
+}
+
+// Contains returns true if the capability is granted, false otherwise
+func (obj *capabilities) Contains(name string) bool {
+	_, ok := obj.mp[name]
+	return ok
+}
+
+// Intersection returns the capabilities granted by both the current and the provided capabilities
+func (obj *capabilities) Intersection(capabilities Capabilities) Capabilities {
+	list := []Capability{}
+	mp := map[string]Capability{}
+	for _, oneCapability := range obj.list {
+		name := oneCapability.Name()
+		other, err := capabilities.Fetch(name)
+		if err != nil {
+			continue
+		}
+
+		path := oneCapability.Path()
+		otherPath := other.Path()
+		if isWithin(otherPath, path) {
+			path = otherPath
+		} else if !isWithin(path, otherPath) {
+			continue
+		}
+
+		ins := createCapabilityWithPath(name, path)
+		list = append(list, ins)
+		mp[name] = ins
+	}
+
+	return createCapabilities(list, mp)
+}
+
+// String returns the capabilities as a sorted manifest
+func (obj *capabilities) String() string {
+	list := []string{}
+	for _, oneCapability := range obj.list {
+		list = append(list, oneCapability.String())
+	}
+
+	sort.Strings(list)
+	return strings.Join(list, " ")
+}
+
+func isWithin(path string, root string) bool {
+	if root == "" || root == path {
+		return true
+	}
+
+	return strings.HasPrefix(path, root+string(filepath.Separator))
+}
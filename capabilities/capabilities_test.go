@@ -0,0 +1,77 @@
+package capabilities
+
+import (
+	"testing"
+)
+
+func TestAdapter_fromScriptHeader_Success(t *testing.T) {
+	script := []byte(`
+		// my script
+		// capabilities: file.read:data, list
+		module $list:0;;
+	`)
+
+	ins, isDeclared, err := NewAdapter().FromScriptHeader(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !isDeclared {
+		t.Errorf("the capabilities were expected to be declared")
+		return
+	}
+
+	expected := "file.read:data list"
+	if ins.String() != expected {
+		t.Errorf("the capabilities were expected to be '%s', '%s' returned", expected, ins.String())
+		return
+	}
+}
+
+func TestAdapter_fromScriptHeader_notDeclared_Success(t *testing.T) {
+	script := []byte(`
+		module $list:0;;
+		// capabilities: file.read
+	`)
+
+	_, isDeclared, err := NewAdapter().FromScriptHeader(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if isDeclared {
+		t.Errorf("the capabilities were expected to NOT be declared")
+		return
+	}
+}
+
+func TestAdapter_toCapabilities_invalidName_returnsError(t *testing.T) {
+	_, err := NewAdapter().ToCapabilities([]byte("file.read, network"))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestAdapter_toCapabilities_pathSeeksBeforeBase_returnsError(t *testing.T) {
+	_, err := NewAdapter().ToCapabilities([]byte("file.read:../data"))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestCapabilities_intersection_Success(t *testing.T) {
+	adapter := NewAdapter()
+	caller, _ := adapter.ToCapabilities([]byte("file.read:data file.write:data/out list vm"))
+	callee, _ := adapter.ToCapabilities([]byte("file.read:data/in file.write:logs cast vm"))
+
+	ins := caller.Intersection(callee)
+	expected := "file.read:data/in vm"
+	if ins.String() != expected {
+		t.Errorf("the capabilities were expected to be '%s', '%s' returned", expected, ins.String())
+		return
+	}
+}
@@ -0,0 +1,57 @@
+package capabilities
+
+import "path/filepath"
+
+type capability struct {
+	name string
+	path string
+}
+
+func createCapability(
+	name string,
+) Capability {
+	return createCapabilityInternally(name, "")
+}
+
+func createCapabilityWithPath(
+	name string,
+	path string,
+) Capability {
+	return createCapabilityInternally(name, path)
+}
+
+func createCapabilityInternally(
+	name string,
+	path string,
+) Capability {
+	out := capability{
+		name: name,
+		path: path,
+	}
+
+	return &out
+}
+
+// Name returns the name
+func (obj *capability) Name() string {
+	return obj.name
+}
+
+// HasPath returns true if there is a path, false otherwise
+func (obj *capability) HasPath() bool {
+	return obj.path != ""
+}
+
+// Path returns the path, if any
+func (obj *capability) Path() string {
+	return obj.path
+}
+
+// String returns the capability as it is declared in a manifest
+func (obj *capability) String() string {
+	if obj.HasPath() {
+		return obj.name + pathDelimiter + filepath.ToSlash(obj.path)
+	}
+
+	return obj.name
+}
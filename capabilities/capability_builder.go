@@ -0,0 +1,73 @@
+package capabilities
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+type capabilityBuilder struct {
+	name string
+	path string
+}
+
+func createCapabilityBuilder() CapabilityBuilder {
+	out := capabilityBuilder{
+		name: "",
+		path: "",
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *capabilityBuilder) Create() CapabilityBuilder {
+	return createCapabilityBuilder()
+}
+
+// WithName adds a name to the builder
+func (app *capabilityBuilder) WithName(name string) CapabilityBuilder {
+	app.name = name
+	return app
+}
+
+// WithPath adds a path to the builder
+func (app *capabilityBuilder) WithPath(path string) CapabilityBuilder {
+	app.path = path
+	return app
+}
+
+// Now builds a new Capability instance
+func (app *capabilityBuilder) Now() (Capability, error) {
+	if app.name == "" {
+		return nil, errors.New("the name is mandatory in order to build a Capability instance")
+	}
+
+	isKnown := false
+	for _, oneName := range Names() {
+		if oneName == app.name {
+			isKnown = true
+			break
+		}
+	}
+
+	if !isKnown {
+		str := fmt.Sprintf("the capability (name: %s) is undefined", app.name)
+		return nil, errors.New(str)
+	}
+
+	if app.path != "" {
+		path := filepath.Clean(filepath.FromSlash(app.path))
+		if filepath.IsAbs(path) || path == ".." || strings.HasPrefix(path, ".."+string(filepath.Separator)) {
+			str := fmt.Sprintf("the capability (name: %s) contains a path (%s) that was expected to be relative and to not seek before the base directory", app.name, app.path)
+			return nil, errors.New(str)
+		}
+
+		if path != "." {
+			return createCapabilityWithPath(app.name, path), nil
+		}
+	}
+
+	return createCapability(app.name), nil
+}
@@ -0,0 +1,139 @@
+package capabilities
+
+const (
+	// List represents the capability granting the list modules
+	List = "list"
+
+	// Cast represents the capability granting the cast modules
+	Cast = "cast"
+
+	// Map represents the capability granting the map modules
+	Map = "map"
+
+	// Math represents the capability granting the arithmetic modules
+	Math = "math"
+
+	// Logic represents the capability granting the comparison and boolean modules
+	Logic = "logic"
+
+	// Text represents the capability granting the bytes and text modules
+	Text = "text"
+
+	// Encoding represents the capability granting the encoding and decoding modules
+	Encoding = "encoding"
+
+	// Crypto represents the capability granting the cryptographic modules, the keys being read and written with the file capabilities
+	Crypto = "crypto"
+
+	// FileRead represents the capability granting the file modules needed to read files
+	FileRead = "file.read"
+
+	// FileWrite represents the capability granting the file modules needed to write files
+	FileWrite = "file.write"
+
+	// FileLock represents the capability granting the file lock modules
+	FileLock = "file.lock"
+
+	// AST represents the capability granting the modules building grammars
+	AST = "ast"
+
+	// ASTExecute represents the capability granting the module executing grammars
+	ASTExecute = "ast.execute"
+
+	// Tree represents the capability granting the modules inspecting the trees returned by the executed grammars
+	Tree = "tree"
+
+	// Query represents the capability granting the modules building queries and executing them on trees
+	Query = "query"
+
+	// VM represents the capability granting the modules lexing, parsing and interpreting nested scripts
+	VM = "vm"
+
+	// Error represents the capability granting the modules reading caught errors
+	Error = "error"
+)
+
+const pathDelimiter = ":"
+const headerPrefix = "//"
+const headerKeyword = "capabilities:"
+
+// Names returns the names of every capability
+func Names() []string {
+	return []string{
+		List,
+		Cast,
+		Map,
+		Math,
+		Logic,
+		Text,
+		Encoding,
+		Crypto,
+		FileRead,
+		FileWrite,
+		FileLock,
+		AST,
+		ASTExecute,
+		Tree,
+		Query,
+		VM,
+		Error,
+	}
+}
+
+// NewAdapter creates a new adapter instance
+func NewAdapter() Adapter {
+	builder := NewBuilder()
+	capabilityBuilder := NewCapabilityBuilder()
+	return createAdapter(builder, capabilityBuilder)
+}
+
+// NewBuilder creates a new builder instance
+func NewBuilder() Builder {
+	return createBuilder()
+}
+
+// NewCapabilityBuilder creates a new capability builder instance
+func NewCapabilityBuilder() CapabilityBuilder {
+	return createCapabilityBuilder()
+}
+
+// Adapter represents a capabilities adapter
+type Adapter interface {
+	// ToCapabilities converts a manifest (name[:path] entries separated by spaces or commas) to capabilities
+	ToCapabilities(manifest []byte) (Capabilities, error)
+
+	// FromScriptHeader returns the capabilities declared in the leading comments of a script, if any
+	FromScriptHeader(script []byte) (Capabilities, bool, error)
+}
+
+// Builder represents a capabilities builder
+type Builder interface {
+	Create() Builder
+	WithList(list []Capability) Builder
+	Now() (Capabilities, error)
+}
+
+// Capabilities represents the capabilities granted to a script
+type Capabilities interface {
+	List() []Capability
+	Fetch(name string) (Capability, error)
+	Contains(name string) bool
+	Intersection(capabilities Capabilities) Capabilities
+	String() string
+}
+
+// CapabilityBuilder represents a capability builder
+type CapabilityBuilder interface {
+	Create() CapabilityBuilder
+	WithName(name string) CapabilityBuilder
+	WithPath(path string) CapabilityBuilder
+	Now() (Capability, error)
+}
+
+// Capability represents a capability granting a group of modules, optionally under a sub-path of the base path
+type Capability interface {
+	Name() string
+	HasPath() bool
+	Path() string
+	String() string
+}
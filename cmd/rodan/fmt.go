@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/steve-care-software/rodan/formatters"
+)
+
+// format prints the scripts in their canonical form, returning 1 if a script is not formatted in check mode
+func format(args []string, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("fmt", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	isCheck := flags.Bool("check", false, "lists the scripts that are not formatted instead of printing them")
+	isWrite := flags.Bool("w", false, "writes the formatted scripts back to their files instead of printing them")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	paths := flags.Args()
+	if len(paths) < 1 {
+		fmt.Fprint(stderr, "usage: rodan fmt [-check] [-w] <path>...\n")
+		return 2
+	}
+
+	formatter := formatters.NewFormatter()
+	status := 0
+	for _, onePath := range paths {
+		script, err := ioutil.ReadFile(onePath)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s\n", err.Error())
+			status = 2
+			continue
+		}
+
+		output, err := formatter.Format(script)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %s\n", onePath, err.Error())
+			status = 2
+			continue
+		}
+
+		if *isCheck {
+			if !bytes.Equal(script, output) {
+				fmt.Fprintf(stdout, "%s\n", onePath)
+				if status == 0 {
+					status = 1
+				}
+			}
+
+			continue
+		}
+
+		if *isWrite {
+			if bytes.Equal(script, output) {
+				continue
+			}
+
+			info, err := os.Stat(onePath)
+			if err != nil {
+				fmt.Fprintf(stderr, "%s\n", err.Error())
+				status = 2
+				continue
+			}
+
+			err = ioutil.WriteFile(onePath, output, info.Mode())
+			if err != nil {
+				fmt.Fprintf(stderr, "%s\n", err.Error())
+				status = 2
+			}
+
+			continue
+		}
+
+		stdout.Write(output)
+	}
+
+	return status
+}
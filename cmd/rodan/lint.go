@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/steve-care-software/rodan/linters"
+	"github.com/steve-care-software/rodan/modules"
+)
+
+// lint prints the problems found in the scripts, returning 1 if there is any
+func lint(paths []string, stdout io.Writer, stderr io.Writer) int {
+	if len(paths) < 1 {
+		fmt.Fprint(stderr, "usage: rodan lint <path>...\n")
+		return 2
+	}
+
+	linter, err := linters.NewBuilder().Create().WithInputs(modules.Inputs()).Now()
+	if err != nil {
+		fmt.Fprintf(stderr, "%s\n", err.Error())
+		return 2
+	}
+
+	status := 0
+	for _, onePath := range paths {
+		script, err := ioutil.ReadFile(onePath)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s\n", err.Error())
+			status = 2
+			continue
+		}
+
+		diagnostics, err := linter.Lint(script)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %s\n", onePath, err.Error())
+			status = 2
+			continue
+		}
+
+		for _, oneDiagnostic := range diagnostics {
+			fmt.Fprintf(stdout, "%s: instruction %s: %s (%s)\n", onePath, linters.FormatPosition(oneDiagnostic.Position), oneDiagnostic.Message, oneDiagnostic.Rule)
+		}
+
+		if len(diagnostics) > 0 && status == 0 {
+			status = 1
+		}
+	}
+
+	return status
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/steve-care-software/rodan/modules"
+	"github.com/steve-care-software/rodan/servers"
+)
+
+// serve runs the language server over the provided reader and writer until the client exits
+func serve(stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	server, err := servers.NewBuilder().Create().
+		WithInputs(modules.Inputs()).
+		WithNames(modules.Names()).
+		Now()
+
+	if err != nil {
+		fmt.Fprintf(stderr, "%s\n", err.Error())
+		return 2
+	}
+
+	err = server.Serve(stdin, stdout)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s\n", err.Error())
+		return 1
+	}
+
+	return 0
+}
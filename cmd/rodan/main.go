@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+const usage = `usage: rodan <command> [arguments]
+
+commands:
+	lint <path>...                reports the problems found in the scripts
+	fmt [-check] [-w] <path>...   prints the scripts in their canonical form
+	lsp                           runs the language server over the standard input and output
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	if len(args) < 1 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+
+	switch args[0] {
+	case "lint":
+		return lint(args[1:], stdout, stderr)
+	case "fmt":
+		return format(args[1:], stdout, stderr)
+	case "lsp":
+		return serve(stdin, stdout, stderr)
+	}
+
+	fmt.Fprintf(stderr, "the command (%s) is unknown\n\n%s", args[0], usage)
+	return 2
+}
@@ -0,0 +1,3 @@
+module github.com/steve-care-software/ast
+
+go 1.16
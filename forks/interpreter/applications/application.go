@@ -0,0 +1,1546 @@
+package applications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/steve-care-software/interpreter/domain/instructions"
+	instructions_application "github.com/steve-care-software/interpreter/domain/instructions/applications"
+	"github.com/steve-care-software/interpreter/domain/instructions/attachments"
+	instructions_module "github.com/steve-care-software/interpreter/domain/instructions/modules"
+	"github.com/steve-care-software/interpreter/domain/instructions/parameters"
+	"github.com/steve-care-software/interpreter/domain/programs"
+	"github.com/steve-care-software/interpreter/domain/programs/modules"
+)
+
+type application struct {
+	builder             programs.Builder
+	instructionsBuilder programs.InstructionsBuilder
+	instructionBuilder  programs.InstructionBuilder
+	applicationBuilder  programs.ApplicationBuilder
+	attachmentsBuilder  programs.AttachmentsBuilder
+	attachmentBuilder   programs.AttachmentBuilder
+	valueBuilder        programs.ValueBuilder
+	conditionBuilder    programs.ConditionBuilder
+	loopBuilder         programs.LoopBuilder
+	tryBuilder          programs.TryBuilder
+	nameBytesToStringFn NameBytesToString
+	importFn            ImportFn
+}
+
+func createApplication(
+	builder programs.Builder,
+	instructionsBuilder programs.InstructionsBuilder,
+	instructionBuilder programs.InstructionBuilder,
+	applicationBuilder programs.ApplicationBuilder,
+	attachmentsBuilder programs.AttachmentsBuilder,
+	attachmentBuilder programs.AttachmentBuilder,
+	valueBuilder programs.ValueBuilder,
+	conditionBuilder programs.ConditionBuilder,
+	loopBuilder programs.LoopBuilder,
+	tryBuilder programs.TryBuilder,
+	nameBytesToStringFn NameBytesToString,
+	importFn ImportFn,
+) Application {
+	out := application{
+		builder:             builder,
+		instructionsBuilder: instructionsBuilder,
+		instructionBuilder:  instructionBuilder,
+		applicationBuilder:  applicationBuilder,
+		attachmentsBuilder:  attachmentsBuilder,
+		attachmentBuilder:   attachmentBuilder,
+		valueBuilder:        valueBuilder,
+		conditionBuilder:    conditionBuilder,
+		loopBuilder:         loopBuilder,
+		tryBuilder:          tryBuilder,
+		nameBytesToStringFn: nameBytesToStringFn,
+		importFn:            importFn,
+	}
+	return &out
+}
+
+// Compile compiles modules and instructions to a program instance
+func (app *application) Compile(modulesIns modules.Modules, instructions instructions.Instructions) (programs.Program, error) {
+	return app.CompileContext(context.Background(), modulesIns, instructions)
+}
+
+// CompileContext compiles modules and instructions to a program instance, passing the context to the importFn
+func (app *application) CompileContext(ctx context.Context, modulesIns modules.Modules, instructions instructions.Instructions) (programs.Program, error) {
+	return app.compileProgram(
+		ctx,
+		instructions,
+		map[string]modules.Module{},
+		map[string]programs.Application{},
+		map[string]programs.Value{},
+		modulesIns,
+	)
+}
+
+func (app *application) compileProgram(
+	ctx context.Context,
+	instructions instructions.Instructions,
+	inModules map[string]modules.Module,
+	inApplications map[string]programs.Application,
+	inValues map[string]programs.Value,
+	modulesIns modules.Modules,
+) (programs.Program, error) {
+	list := instructions.List()
+	inParameters := map[string]*parameter{}
+	inInstructions := []programs.Instruction{}
+	inOutput := [][]byte{}
+	for idx, oneInstruction := range list {
+		outModules, outApplications, outParameters, outOutput, outValues, outInstructions, err := app.compileInstruction(
+			ctx,
+			oneInstruction,
+			inModules,
+			inApplications,
+			inParameters,
+			inOutput,
+			inValues,
+			inInstructions,
+			modulesIns,
+		)
+
+		if err != nil {
+			str := fmt.Sprintf("there was an error at instruction (index: %d): %s", idx, err.Error())
+			return nil, errors.New(str)
+		}
+
+		inModules = outModules
+		inApplications = outApplications
+		inParameters = outParameters
+		inOutput = outOutput
+		inValues = outValues
+		inInstructions = outInstructions
+	}
+
+	ins, err := app.instructionsBuilder.Create().WithList(inInstructions).Now()
+	if err != nil {
+		return nil, err
+	}
+
+	builder := app.builder.Create().WithInstructions(ins)
+	inputs := app.inputs(inParameters)
+	if len(inputs) > 0 {
+		builder.WithInputs(inputs)
+	}
+
+	if len(inOutput) > 0 {
+		builder.WithOutputs(inOutput)
+	}
+
+	return builder.Now()
+}
+
+func (app *application) inputs(inParameters map[string]*parameter) [][]byte {
+	inputs := make([][]byte, 0)
+	for _, oneParameter := range inParameters {
+		if !oneParameter.parameter.IsInput() {
+			continue
+		}
+
+		inputs = append(inputs, nil)
+	}
+
+	for _, oneParameter := range inParameters {
+		if !oneParameter.parameter.IsInput() {
+			continue
+		}
+
+		inputs[oneParameter.inputParameterIndex] = oneParameter.parameter.Name()
+	}
+
+	return inputs
+}
+
+func (app *application) compileInstruction(
+	ctx context.Context,
+	instruction instructions.Instruction,
+	inModules map[string]modules.Module,
+	inApplications map[string]programs.Application,
+	inParameters map[string]*parameter,
+	inOutput [][]byte,
+	inValues map[string]programs.Value,
+	inInstructions []programs.Instruction,
+	allModules modules.Modules,
+) (map[string]modules.Module, map[string]programs.Application, map[string]*parameter, [][]byte, map[string]programs.Value, []programs.Instruction, error) {
+	if instruction.IsModule() {
+		name := instruction.Module()
+		outModules, err := app.compileModule(name, inModules, allModules)
+		if err != nil {
+			return nil, nil, nil, nil, nil, nil, err
+		}
+
+		return outModules, inApplications, inParameters, inOutput, inValues, inInstructions, nil
+	}
+
+	if instruction.IsApplication() {
+		insApp := instruction.Application()
+		outApplications, err := app.compileApplication(insApp, inModules, inApplications)
+		if err != nil {
+			return nil, nil, nil, nil, nil, nil, err
+		}
+
+		return inModules, outApplications, inParameters, inOutput, inValues, inInstructions, nil
+	}
+
+	if instruction.IsParameter() {
+		insParameter := instruction.Parameter()
+		outParameters, err := app.compileParameter(insParameter, inParameters)
+		if err != nil {
+			return nil, nil, nil, nil, nil, nil, err
+		}
+
+		// a parameter shadows the variable of the same name assigned in an enclosing program:
+		delete(inValues, app.nameBytesToStringFn(insParameter.Name()))
+
+		return inModules, inApplications, outParameters, inOutput, inValues, inInstructions, nil
+	}
+
+	if instruction.IsAssignment() {
+		assignment := instruction.Assignment()
+		variableName := assignment.Variable()
+		variableNameStr := app.nameBytesToStringFn(variableName)
+		outOutput := inOutput
+		var valueIns programs.Value
+		if assignment.Value().IsLoop() {
+			loop, loopOutput, err := app.compileLoop(ctx, assignment.Value().Loop(), inModules, inApplications, inParameters, outOutput, inValues, allModules)
+			if err != nil {
+				return nil, nil, nil, nil, nil, nil, err
+			}
+
+			if !loop.HasOutput() {
+				str := fmt.Sprintf("the loop assigned to the variable (name: %s) must declare an output parameter in its instructions", variableNameStr)
+				return nil, nil, nil, nil, nil, nil, errors.New(str)
+			}
+
+			valueIns, err = app.valueBuilder.Create().WithLoop(loop).Now()
+			if err != nil {
+				return nil, nil, nil, nil, nil, nil, err
+			}
+
+			outOutput = loopOutput
+		} else if assignment.Value().IsInstructions() {
+			compiledValue, err := app.compileCallable(ctx, assignment, inModules, inApplications, inParameters, inValues, allModules)
+			if err != nil {
+				return nil, nil, nil, nil, nil, nil, err
+			}
+
+			valueIns = compiledValue
+		} else {
+			compiledValue, err := app.compileValue(assignment, inParameters, inValues, inApplications, allModules)
+			if err != nil {
+				return nil, nil, nil, nil, nil, nil, err
+			}
+
+			valueIns = compiledValue
+		}
+
+		reference, err := app.valueBuilder.Create().WithVariable(variableName).Now()
+		if err != nil {
+			return nil, nil, nil, nil, nil, nil, err
+		}
+
+		outValues := inValues
+		outValues[variableNameStr] = reference
+
+		ins, err := app.instructionBuilder.Create().WithValue(valueIns).WithVariable(variableName).Now()
+		if err != nil {
+			return nil, nil, nil, nil, nil, nil, err
+		}
+
+		if param, ok := inParameters[variableNameStr]; ok {
+			if !param.parameter.IsInput() {
+				outOutput = app.appendOutput(outOutput, variableName)
+			}
+		}
+
+		outInstructions := append(inInstructions, ins)
+		return inModules, inApplications, inParameters, outOutput, outValues, outInstructions, nil
+	}
+
+	if instruction.IsAttachment() {
+		attachment := instruction.Attachment()
+		outApplications, err := app.compileAttachment(attachment, inParameters, inValues, inApplications, allModules)
+		if err != nil {
+			return nil, nil, nil, nil, nil, nil, err
+		}
+
+		return inModules, outApplications, inParameters, inOutput, inValues, inInstructions, nil
+	}
+
+	if instruction.IsCondition() {
+		condition := instruction.Condition()
+		outValues, outOutput, outInstructions, err := app.compileCondition(
+			ctx,
+			condition,
+			inModules,
+			inApplications,
+			inParameters,
+			inOutput,
+			inValues,
+			inInstructions,
+			allModules,
+		)
+
+		if err != nil {
+			return nil, nil, nil, nil, nil, nil, err
+		}
+
+		return inModules, inApplications, inParameters, outOutput, outValues, outInstructions, nil
+	}
+
+	if instruction.IsLoop() {
+		loop, outOutput, err := app.compileLoop(ctx, instruction.Loop(), inModules, inApplications, inParameters, inOutput, inValues, allModules)
+		if err != nil {
+			return nil, nil, nil, nil, nil, nil, err
+		}
+
+		ins, err := app.instructionBuilder.Create().WithLoop(loop).Now()
+		if err != nil {
+			return nil, nil, nil, nil, nil, nil, err
+		}
+
+		outInstructions := append(inInstructions, ins)
+		return inModules, inApplications, inParameters, outOutput, inValues, outInstructions, nil
+	}
+
+	if instruction.IsTry() {
+		outValues, outOutput, outInstructions, err := app.compileTry(
+			ctx,
+			instruction.Try(),
+			inModules,
+			inApplications,
+			inParameters,
+			inOutput,
+			inValues,
+			inInstructions,
+			allModules,
+		)
+
+		if err != nil {
+			return nil, nil, nil, nil, nil, nil, err
+		}
+
+		return inModules, inApplications, inParameters, outOutput, outValues, outInstructions, nil
+	}
+
+	if instruction.IsFail() {
+		value, err := app.compileVariableValue(instruction.Fail(), "fail", inParameters, inValues)
+		if err != nil {
+			return nil, nil, nil, nil, nil, nil, err
+		}
+
+		ins, err := app.instructionBuilder.Create().WithFail(value).Now()
+		if err != nil {
+			return nil, nil, nil, nil, nil, nil, err
+		}
+
+		outInstructions := append(inInstructions, ins)
+		return inModules, inApplications, inParameters, inOutput, inValues, outInstructions, nil
+	}
+
+	if instruction.IsImport() {
+		outValues, outInstructions, err := app.compileImport(ctx, instruction.Import(), inApplications, inValues, inInstructions)
+		if err != nil {
+			return nil, nil, nil, nil, nil, nil, err
+		}
+
+		return inModules, inApplications, inParameters, inOutput, outValues, outInstructions, nil
+	}
+
+	execution := instruction.Execution()
+	outInstructions, err := app.compileExecution(execution, inApplications, inInstructions)
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
+	return inModules, inApplications, inParameters, inOutput, inValues, outInstructions, nil
+}
+
+func (app *application) appendOutput(outputs [][]byte, name []byte) [][]byte {
+	nameStr := app.nameBytesToStringFn(name)
+	for _, oneOutput := range outputs {
+		if app.nameBytesToStringFn(oneOutput) == nameStr {
+			return outputs
+		}
+	}
+
+	return append(outputs, name)
+}
+
+func (app *application) compileCondition(
+	ctx context.Context,
+	condition instructions.Condition,
+	inModules map[string]modules.Module,
+	inApplications map[string]programs.Application,
+	inParameters map[string]*parameter,
+	inOutput [][]byte,
+	inValues map[string]programs.Value,
+	inInstructions []programs.Instruction,
+	allModules modules.Modules,
+) (map[string]programs.Value, [][]byte, []programs.Instruction, error) {
+	variable := condition.Variable()
+	value, err := app.compileVariableValue(variable, "condition", inParameters, inValues)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	outOutput := inOutput
+	branchesValues := []map[string]programs.Value{}
+	builder := app.conditionBuilder.Create().WithValue(value)
+	if condition.HasThen() {
+		then, thenValues, thenOutput, err := app.compileBranch(ctx, condition.Then().List(), inModules, inApplications, inParameters, outOutput, inValues, allModules)
+		if err != nil {
+			str := fmt.Sprintf("there was an error in the then branch of the condition (variable: %s): %s", variable, err.Error())
+			return nil, nil, nil, errors.New(str)
+		}
+
+		if then != nil {
+			builder.WithThen(then)
+		}
+
+		outOutput = thenOutput
+		branchesValues = append(branchesValues, thenValues)
+	}
+
+	if condition.HasElse() {
+		els, elseValues, elseOutput, err := app.compileBranch(ctx, condition.Else().List(), inModules, inApplications, inParameters, outOutput, inValues, allModules)
+		if err != nil {
+			str := fmt.Sprintf("there was an error in the else branch of the condition (variable: %s): %s", variable, err.Error())
+			return nil, nil, nil, errors.New(str)
+		}
+
+		if els != nil {
+			builder.WithElse(els)
+		}
+
+		outOutput = elseOutput
+		branchesValues = append(branchesValues, elseValues)
+	}
+
+	// the variables assigned in a branch remain referenceable after the condition:
+	outValues := inValues
+	for _, oneBranchValues := range branchesValues {
+		for name, oneValue := range oneBranchValues {
+			outValues[name] = oneValue
+		}
+	}
+
+	conditionIns, err := builder.Now()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	ins, err := app.instructionBuilder.Create().WithCondition(conditionIns).Now()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	outInstructions := append(inInstructions, ins)
+	return outValues, outOutput, outInstructions, nil
+}
+
+func (app *application) compileTry(
+	ctx context.Context,
+	try instructions.Try,
+	inModules map[string]modules.Module,
+	inApplications map[string]programs.Application,
+	inParameters map[string]*parameter,
+	inOutput [][]byte,
+	inValues map[string]programs.Value,
+	inInstructions []programs.Instruction,
+	allModules modules.Modules,
+) (map[string]programs.Value, [][]byte, []programs.Instruction, error) {
+	variable := try.Variable()
+	variableNameStr := app.nameBytesToStringFn(variable)
+	outOutput := inOutput
+	branchesValues := []map[string]programs.Value{}
+	builder := app.tryBuilder.Create().WithVariable(variable)
+	if try.HasInstructions() {
+		body, bodyValues, bodyOutput, err := app.compileBranch(ctx, try.Instructions().List(), inModules, inApplications, inParameters, outOutput, inValues, allModules)
+		if err != nil {
+			str := fmt.Sprintf("there was an error in the instructions of the try (variable: %s): %s", variable, err.Error())
+			return nil, nil, nil, errors.New(str)
+		}
+
+		if body != nil {
+			builder.WithInstructions(body)
+		}
+
+		outOutput = bodyOutput
+		branchesValues = append(branchesValues, bodyValues)
+	}
+
+	if try.HasCatch() {
+		// the error is only referenceable inside the catch branch:
+		catchValues := map[string]programs.Value{}
+		for name, oneValue := range inValues {
+			catchValues[name] = oneValue
+		}
+
+		reference, err := app.valueBuilder.Create().WithVariable(variable).Now()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		catchValues[variableNameStr] = reference
+		catch, catchBranchValues, catchOutput, err := app.compileBranch(ctx, try.Catch().List(), inModules, inApplications, inParameters, outOutput, catchValues, allModules)
+		if err != nil {
+			str := fmt.Sprintf("there was an error in the catch branch of the try (variable: %s): %s", variable, err.Error())
+			return nil, nil, nil, errors.New(str)
+		}
+
+		if catch != nil {
+			builder.WithCatch(catch)
+		}
+
+		if previous, ok := inValues[variableNameStr]; ok {
+			catchBranchValues[variableNameStr] = previous
+		} else {
+			delete(catchBranchValues, variableNameStr)
+		}
+
+		outOutput = catchOutput
+		branchesValues = append(branchesValues, catchBranchValues)
+	}
+
+	// the variables assigned in a branch remain referenceable after the try:
+	outValues := inValues
+	for _, oneBranchValues := range branchesValues {
+		for name, oneValue := range oneBranchValues {
+			outValues[name] = oneValue
+		}
+	}
+
+	tryIns, err := builder.Now()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	ins, err := app.instructionBuilder.Create().WithTry(tryIns).Now()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	outInstructions := append(inInstructions, ins)
+	return outValues, outOutput, outInstructions, nil
+}
+
+func (app *application) compileLoop(
+	ctx context.Context,
+	loop instructions.Loop,
+	inModules map[string]modules.Module,
+	inApplications map[string]programs.Application,
+	inParameters map[string]*parameter,
+	inOutput [][]byte,
+	inValues map[string]programs.Value,
+	allModules modules.Modules,
+) (programs.Loop, [][]byte, error) {
+	variable := loop.Variable()
+	value, err := app.compileVariableValue(variable, "loop", inParameters, inValues)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the item is only referenceable inside the loop's instructions:
+	loopValues := map[string]programs.Value{}
+	for name, oneValue := range inValues {
+		loopValues[name] = oneValue
+	}
+
+	builder := app.loopBuilder.Create().WithValue(value)
+	if loop.HasItem() {
+		item := loop.Item()
+		reference, err := app.valueBuilder.Create().WithVariable(item).Now()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		loopValues[app.nameBytesToStringFn(item)] = reference
+		builder.WithItem(item)
+	}
+
+	if loop.HasMaximum() {
+		builder.WithMaximum(*loop.Maximum())
+	}
+
+	outOutput := inOutput
+	if loop.HasInstructions() {
+		var output []byte
+		list := []instructions.Instruction{}
+		for idx, oneInstruction := range loop.Instructions().List() {
+			if !oneInstruction.IsParameter() {
+				list = append(list, oneInstruction)
+				continue
+			}
+
+			parameter := oneInstruction.Parameter()
+			if parameter.IsInput() {
+				str := fmt.Sprintf("the input parameter (name: %s) at instruction (index: %d) cannot be declared inside a loop", parameter.Name(), idx)
+				return nil, nil, errors.New(str)
+			}
+
+			if output != nil {
+				str := fmt.Sprintf("the output parameter (name: %s) at instruction (index: %d) cannot be declared because the loop already declares an output parameter (name: %s)", parameter.Name(), idx, output)
+				return nil, nil, errors.New(str)
+			}
+
+			output = parameter.Name()
+		}
+
+		body, bodyValues, bodyOutput, err := app.compileBranch(ctx, list, inModules, inApplications, inParameters, outOutput, loopValues, allModules)
+		if err != nil {
+			str := fmt.Sprintf("there was an error in the instructions of the loop (variable: %s): %s", variable, err.Error())
+			return nil, nil, errors.New(str)
+		}
+
+		if output != nil {
+			if _, ok := bodyValues[app.nameBytesToStringFn(output)]; !ok {
+				str := fmt.Sprintf("the output parameter (name: %s) of the loop (variable: %s) is never assigned in its instructions", output, variable)
+				return nil, nil, errors.New(str)
+			}
+
+			builder.WithOutput(output)
+		}
+
+		if body != nil {
+			builder.WithInstructions(body)
+		}
+
+		outOutput = bodyOutput
+	}
+
+	ins, err := builder.Now()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ins, outOutput, nil
+}
+
+func (app *application) compileBranch(
+	ctx context.Context,
+	list []instructions.Instruction,
+	inModules map[string]modules.Module,
+	inApplications map[string]programs.Application,
+	inParameters map[string]*parameter,
+	inOutput [][]byte,
+	inValues map[string]programs.Value,
+	allModules modules.Modules,
+) (programs.Instructions, map[string]programs.Value, [][]byte, error) {
+	// the modules and applications declared, or attached to, in a branch are scoped to it:
+	branchModules := map[string]modules.Module{}
+	for name, oneModule := range inModules {
+		branchModules[name] = oneModule
+	}
+
+	branchApplications := map[string]programs.Application{}
+	for name, oneApplication := range inApplications {
+		branchApplications[name] = oneApplication
+	}
+
+	branchValues := map[string]programs.Value{}
+	for name, oneValue := range inValues {
+		branchValues[name] = oneValue
+	}
+
+	branchOutput := append([][]byte{}, inOutput...)
+	branchInstructions := []programs.Instruction{}
+	for idx, oneInstruction := range list {
+		if oneInstruction.IsParameter() {
+			str := fmt.Sprintf("the parameter (name: %s) at instruction (index: %d) cannot be declared inside a branch", oneInstruction.Parameter().Name(), idx)
+			return nil, nil, nil, errors.New(str)
+		}
+
+		outModules, outApplications, _, outOutput, outValues, outInstructions, err := app.compileInstruction(
+			ctx,
+			oneInstruction,
+			branchModules,
+			branchApplications,
+			inParameters,
+			branchOutput,
+			branchValues,
+			branchInstructions,
+			allModules,
+		)
+
+		if err != nil {
+			str := fmt.Sprintf("there was an error at instruction (index: %d): %s", idx, err.Error())
+			return nil, nil, nil, errors.New(str)
+		}
+
+		branchModules = outModules
+		branchApplications = outApplications
+		branchOutput = outOutput
+		branchValues = outValues
+		branchInstructions = outInstructions
+	}
+
+	if len(branchInstructions) <= 0 {
+		return nil, branchValues, branchOutput, nil
+	}
+
+	ins, err := app.instructionsBuilder.Create().WithList(branchInstructions).Now()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return ins, branchValues, branchOutput, nil
+}
+
+func (app *application) compileVariableValue(
+	variable []byte,
+	usage string,
+	inParameters map[string]*parameter,
+	inValues map[string]programs.Value,
+) (programs.Value, error) {
+	variableNameStr := app.nameBytesToStringFn(variable)
+	if variableIns, ok := inValues[variableNameStr]; ok {
+		return variableIns, nil
+	}
+
+	if parameter, ok := inParameters[variableNameStr]; ok {
+		if !parameter.parameter.IsInput() {
+			str := fmt.Sprintf("the output variable (name: %s, parameter index: %d) cannot be used in a %s", variableNameStr, parameter.allParameterIndex, usage)
+			return nil, errors.New(str)
+		}
+
+		return app.valueBuilder.Create().WithInput(parameter.inputParameterIndex).Now()
+	}
+
+	str := fmt.Sprintf("the variable (name: %s) is undeclared and therefore cannot be used in a %s", variableNameStr, usage)
+	return nil, errors.New(str)
+}
+
+func (app *application) compileExecution(
+	execution []byte,
+	inApplications map[string]programs.Application,
+	inInstructions []programs.Instruction,
+) ([]programs.Instruction, error) {
+	executionNameStr := app.nameBytesToStringFn(execution)
+	if _, ok := inApplications[executionNameStr]; !ok {
+		str := fmt.Sprintf("the application's execution (name: %s) is invalid because the application is undefined", executionNameStr)
+		return nil, errors.New(str)
+	}
+
+	ins, err := app.instructionBuilder.Create().WithExecution(inApplications[executionNameStr]).Now()
+	if err != nil {
+		return nil, err
+	}
+
+	outInstructions := append(inInstructions, ins)
+	return outInstructions, nil
+}
+
+func (app *application) compileAttachment(
+	attachment attachments.Attachment,
+	inParameters map[string]*parameter,
+	inValues map[string]programs.Value,
+	inApplications map[string]programs.Application,
+	allModules modules.Modules,
+) (map[string]programs.Application, error) {
+	variable := attachment.Variable()
+	currentValue, err := app.compileAttachmentValue(variable, inParameters, inValues, allModules)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationName := attachment.Application()
+	applicationNameStr := app.nameBytesToStringFn(applicationName)
+	if appIns, ok := inApplications[applicationNameStr]; ok {
+		target := variable.Target()
+		attachment, err := app.attachmentBuilder.Create().WithValue(currentValue).WithLocal(target).Now()
+		if err != nil {
+			return nil, err
+		}
+
+		attachmentsList := []programs.Attachment{}
+		if appIns.HasAttachments() {
+			attachmentsList = appIns.Attachments().List()
+		}
+
+		attachmentsList = append(attachmentsList, attachment)
+		attachments, err := app.attachmentsBuilder.Create().WithList(attachmentsList).Now()
+		if err != nil {
+			return nil, err
+		}
+
+		index := appIns.Index()
+		module := appIns.Module()
+		updatedAppIns, err := app.applicationBuilder.Create().
+			WithIndex(index).
+			WithModule(module).
+			WithCallable(appIns.Callable()).
+			WithAttachments(attachments).
+			WithName(appIns.Name()).
+			WithModuleName(appIns.ModuleName()).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		inApplications[applicationNameStr] = updatedAppIns
+		return inApplications, nil
+	}
+
+	str := fmt.Sprintf("the application (name: %s) is undeclared and therefore cannot be used in an attachment", applicationName)
+	return nil, errors.New(str)
+}
+
+func (app *application) compileAttachmentValue(
+	variable attachments.Variable,
+	inParameters map[string]*parameter,
+	inValues map[string]programs.Value,
+	allModules modules.Modules,
+) (programs.Value, error) {
+	current := variable.Current()
+	currentNameStr := app.nameBytesToStringFn(current)
+	if currentIns, ok := inValues[currentNameStr]; ok {
+		return currentIns, nil
+	}
+
+	if parameter, ok := inParameters[currentNameStr]; ok {
+		if !parameter.parameter.IsInput() {
+			str := fmt.Sprintf("the output variable (name: %s, parameter index: %d) cannot be used in attachment", currentNameStr, parameter.allParameterIndex)
+			return nil, errors.New(str)
+		}
+
+		return app.valueBuilder.Create().WithInput(parameter.inputParameterIndex).Now()
+	}
+
+	str := fmt.Sprintf("the current variable (name: %s) is undeclared and therefore cannot be used in an attachment", currentNameStr)
+	return nil, errors.New(str)
+}
+
+func (app *application) compileValue(
+	assignment instructions.Assignment,
+	inParameters map[string]*parameter,
+	inValues map[string]programs.Value,
+	inApplications map[string]programs.Application,
+	allModules modules.Modules,
+) (programs.Value, error) {
+	variable := assignment.Variable()
+	variableNameStr := app.nameBytesToStringFn(variable)
+
+	value := assignment.Value()
+	builder := app.valueBuilder.Create()
+	if value.IsVariable() {
+		assignedVariable := value.Variable()
+		assignedVariableNameStr := app.nameBytesToStringFn(assignedVariable)
+		if _, ok := inValues[assignedVariableNameStr]; ok {
+			builder.WithVariable(assignedVariable)
+		} else if parameter, ok := inParameters[assignedVariableNameStr]; ok {
+			if !parameter.parameter.IsInput() {
+				str := fmt.Sprintf("the assignment (name: %s) is using an output variable (nme: %s) as value", variableNameStr, assignedVariableNameStr)
+				return nil, errors.New(str)
+			}
+
+			builder.WithInput(parameter.inputParameterIndex)
+		} else {
+			str := fmt.Sprintf("the assignment (name: %s) is using an undefined parameter (name: %s) as value", variableNameStr, assignedVariableNameStr)
+			return nil, errors.New(str)
+		}
+	}
+
+	if value.IsConstant() {
+		constant := value.Constant()
+		builder.WithConstant(constant)
+	}
+
+	if value.IsLiteral() {
+		literal := value.Literal()
+		builder.WithLiteral(literal)
+	}
+
+	if value.IsExecution() {
+		execution := value.Execution()
+		executionNameStr := app.nameBytesToStringFn(execution)
+		if executedApp, ok := inApplications[executionNameStr]; ok {
+			builder.WithExecution(executedApp)
+		} else {
+			str := fmt.Sprintf("the assignment (name: %s) is using an undefined application execution (name: %s) as value", variableNameStr, executionNameStr)
+			return nil, errors.New(str)
+		}
+	}
+
+	return builder.Now()
+}
+
+func (app *application) compileCallable(
+	ctx context.Context,
+	assignment instructions.Assignment,
+	inModules map[string]modules.Module,
+	inApplications map[string]programs.Application,
+	inParameters map[string]*parameter,
+	inValues map[string]programs.Value,
+	allModules modules.Modules,
+) (programs.Value, error) {
+	variable := assignment.Variable()
+	variableNameStr := app.nameBytesToStringFn(variable)
+	appIndex := uint(len(inApplications))
+	if appIns, ok := inApplications[variableNameStr]; ok {
+		if !appIns.IsCallable() {
+			str := fmt.Sprintf("the callable (name: %s) cannot be assigned because an application of the same name is already declared", variableNameStr)
+			return nil, errors.New(str)
+		}
+
+		appIndex = appIns.Index()
+	}
+
+	appIns, err := app.applicationBuilder.Create().
+		WithIndex(appIndex).
+		WithCallable(variable).
+		WithName(variable).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the callable is registered before compiling its instructions so that it can execute itself:
+	inApplications[variableNameStr] = appIns
+
+	// the instructions are lexically scoped: they reference the modules, applications and variables of the enclosing program:
+	callableModules := map[string]modules.Module{}
+	for name, oneModule := range inModules {
+		callableModules[name] = oneModule
+	}
+
+	callableApplications := map[string]programs.Application{}
+	for name, oneApplication := range inApplications {
+		callableApplications[name] = oneApplication
+	}
+
+	callableValues := map[string]programs.Value{}
+	for name, oneValue := range inValues {
+		callableValues[name] = oneValue
+	}
+
+	for name, oneParameter := range inParameters {
+		if !oneParameter.parameter.IsInput() {
+			continue
+		}
+
+		reference, err := app.valueBuilder.Create().WithVariable(oneParameter.parameter.Name()).Now()
+		if err != nil {
+			return nil, err
+		}
+
+		callableValues[name] = reference
+	}
+
+	program, err := app.compileProgram(ctx, assignment.Value().Instructions(), callableModules, callableApplications, callableValues, allModules)
+	if err != nil {
+		str := fmt.Sprintf("there was an error in the instructions of the callable (name: %s): %s", variableNameStr, err.Error())
+		return nil, errors.New(str)
+	}
+
+	return app.valueBuilder.Create().WithProgram(program).Now()
+}
+
+// compileImport assigns the imported program as a callable when it declares inputs, or assigns its output otherwise
+func (app *application) compileImport(
+	ctx context.Context,
+	importIns instructions.Import,
+	inApplications map[string]programs.Application,
+	inValues map[string]programs.Value,
+	inInstructions []programs.Instruction,
+) (map[string]programs.Value, []programs.Instruction, error) {
+	path := importIns.Path()
+	if app.importFn == nil {
+		str := fmt.Sprintf("the script (path: %s) cannot be imported because the application does not support imports", path)
+		return nil, nil, errors.New(str)
+	}
+
+	variable := importIns.Variable()
+	variableNameStr := app.nameBytesToStringFn(variable)
+	appIndex := uint(len(inApplications))
+	if appIns, ok := inApplications[variableNameStr]; ok {
+		if !appIns.IsCallable() {
+			str := fmt.Sprintf("the script (path: %s) cannot be imported as %s because an application of the same name is already declared", path, variableNameStr)
+			return nil, nil, errors.New(str)
+		}
+
+		appIndex = appIns.Index()
+	}
+
+	program, err := app.importFn(ctx, path)
+	if err != nil {
+		str := fmt.Sprintf("there was an error while importing the script (path: %s): %s", path, err.Error())
+		return nil, nil, errors.New(str)
+	}
+
+	appIns, err := app.applicationBuilder.Create().
+		WithIndex(appIndex).
+		WithCallable(variable).
+		WithName(variable).
+		Now()
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	programValue, err := app.valueBuilder.Create().WithProgram(program).Now()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ins, err := app.instructionBuilder.Create().WithValue(programValue).WithVariable(variable).Now()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	outInstructions := append(inInstructions, ins)
+	if program.HasInputs() {
+		inApplications[variableNameStr] = appIns
+	} else {
+		delete(inApplications, variableNameStr)
+		executionValue, err := app.valueBuilder.Create().WithExecution(appIns).Now()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		ins, err := app.instructionBuilder.Create().WithValue(executionValue).WithVariable(variable).Now()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		outInstructions = append(outInstructions, ins)
+	}
+
+	reference, err := app.valueBuilder.Create().WithVariable(variable).Now()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	inValues[variableNameStr] = reference
+	return inValues, outInstructions, nil
+}
+
+func (app *application) compileParameter(
+	parameterIns parameters.Parameter,
+	inParameters map[string]*parameter,
+) (map[string]*parameter, error) {
+	name := parameterIns.Name()
+	parameterNameStr := app.nameBytesToStringFn(name)
+	if _, ok := inParameters[parameterNameStr]; ok {
+		str := fmt.Sprintf("the parameter (name: %s, isInput: %t) is already declared", parameterNameStr, parameterIns.IsInput())
+		return nil, errors.New(str)
+	}
+
+	inputParameterIndex := uint(0)
+	for _, oneParameter := range inParameters {
+		if !oneParameter.parameter.IsInput() {
+			continue
+		}
+
+		inputParameterIndex++
+	}
+
+	inParameters[parameterNameStr] = &parameter{
+		allParameterIndex:   uint(len(inParameters)),
+		inputParameterIndex: inputParameterIndex,
+		parameter:           parameterIns,
+	}
+
+	return inParameters, nil
+}
+
+func (app *application) compileApplication(
+	application instructions_application.Application,
+	inModules map[string]modules.Module,
+	inApplications map[string]programs.Application,
+) (map[string]programs.Application, error) {
+	name := application.Name()
+	appNameStr := app.nameBytesToStringFn(name)
+	if _, ok := inApplications[appNameStr]; ok {
+		str := fmt.Sprintf("the application (name: %s) is already declared", appNameStr)
+		return nil, errors.New(str)
+	}
+
+	module := application.Module()
+	moduleNameStr := app.nameBytesToStringFn(module)
+	if _, ok := inModules[moduleNameStr]; !ok {
+		str := fmt.Sprintf("the module (name: %s) is undefined but used in the application declaration (name: %s)", moduleNameStr, appNameStr)
+		return nil, errors.New(str)
+	}
+
+	appIndex := uint(len(inApplications))
+	ins, err := app.applicationBuilder.Create().
+		WithIndex(appIndex).
+		WithModule(inModules[moduleNameStr]).
+		WithName(name).
+		WithModuleName(module).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	inApplications[appNameStr] = ins
+	return inApplications, nil
+}
+
+func (app *application) compileModule(
+	insModule instructions_module.Module,
+	loadedModules map[string]modules.Module,
+	allModules modules.Modules,
+) (map[string]modules.Module, error) {
+	index := insModule.Index()
+	module, err := allModules.Fetch(index)
+	if err != nil {
+		return nil, err
+	}
+
+	name := insModule.Name()
+	moduleNameStr := app.nameBytesToStringFn(name)
+	if _, ok := loadedModules[moduleNameStr]; ok {
+		str := fmt.Sprintf("the module (index: %d, name: %s) is already loaded", index, moduleNameStr)
+		return nil, errors.New(str)
+	}
+
+	loadedModules[moduleNameStr] = module
+	return loadedModules, nil
+}
+
+// Execute executes a program
+func (app *application) Execute(input []interface{}, program programs.Program) ([]interface{}, error) {
+	return app.ExecuteContext(context.Background(), input, program)
+}
+
+// ExecuteContext executes a program, stopping as soon as the context is done
+func (app *application) ExecuteContext(ctx context.Context, input []interface{}, program programs.Program) ([]interface{}, error) {
+	return app.executeProgram(ctx, input, createFrame(nil), program)
+}
+
+func (app *application) executeProgram(ctx context.Context, input []interface{}, values *frame, program programs.Program) ([]interface{}, error) {
+	// the inputs and outputs belong to the program's frame, even when assigned inside a loop:
+	if program.HasInputs() {
+		for idx, oneInput := range program.Inputs() {
+			if idx >= len(input) {
+				break
+			}
+
+			inputNameStr := app.nameBytesToStringFn(oneInput)
+			values.declare(inputNameStr)
+			values.assign(inputNameStr, input[idx])
+		}
+	}
+
+	if program.HasOutputs() {
+		for _, oneOutput := range program.Outputs() {
+			values.declare(app.nameBytesToStringFn(oneOutput))
+		}
+	}
+
+	err := app.executeInstructions(ctx, input, values, program.Instructions())
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := []interface{}{}
+	if program.HasOutputs() {
+		outputs := program.Outputs()
+		for _, oneOutput := range outputs {
+			outputNameStr := app.nameBytesToStringFn(oneOutput)
+			if ins, ok := values.local(outputNameStr); ok {
+				filtered = append(filtered, ins)
+				continue
+			}
+
+			str := fmt.Sprintf("the program has an output parameter (name: %s), but the executed program did not assign it", outputNameStr)
+			return nil, errors.New(str)
+		}
+	}
+
+	return filtered, nil
+}
+
+func (app *application) executeInstructions(ctx context.Context, input []interface{}, values *frame, instructions programs.Instructions) error {
+	hookFn, depth := fromContext(ctx)
+	if hookFn != nil {
+		ctx = context.WithValue(ctx, depthKey{}, depth+1)
+	}
+
+	parent := positionFromContext(ctx)
+	list := instructions.List()
+	for idx, oneInstruction := range list {
+		err := ctx.Err()
+		if err != nil {
+			return err
+		}
+
+		insCtx := context.WithValue(ctx, positionKey{}, &position{parent: parent, index: uint(idx)})
+		start := time.Now()
+		output, parameters, err := app.executeInstruction(insCtx, input, values, oneInstruction)
+		if hookFn != nil {
+			hookErr := hookFn(insCtx, createEvent(depth, uint(idx), oneInstruction, parameters, output, err, time.Since(start)))
+			if hookErr != nil {
+				return &hookError{err: hookErr}
+			}
+		}
+
+		if err != nil {
+			// the error is described by the instruction that raised it, not by the instructions containing it:
+			var pErr *Error
+			var pHookErr *hookError
+			if !errors.As(err, &pErr) && !errors.As(err, &pHookErr) {
+				err = createError(insCtx, oneInstruction, err)
+			}
+		}
+
+		if oneInstruction.IsValue() {
+			if err != nil {
+				return fmt.Errorf("there was an error while executing an assignment (index: %d): %w", idx, err)
+			}
+
+			if oneInstruction.HasVariable() {
+				variableNameStr := app.nameBytesToStringFn(oneInstruction.Variable())
+				values.assign(variableNameStr, output)
+			}
+
+			continue
+		}
+
+		if oneInstruction.IsCondition() {
+			if err != nil {
+				return fmt.Errorf("there was an error while executing a condition (index: %d): %w", idx, err)
+			}
+
+			continue
+		}
+
+		if oneInstruction.IsLoop() {
+			if err != nil {
+				return fmt.Errorf("there was an error while executing a loop (index: %d): %w", idx, err)
+			}
+
+			continue
+		}
+
+		if oneInstruction.IsTry() {
+			if err != nil {
+				return fmt.Errorf("there was an error while executing a try (index: %d): %w", idx, err)
+			}
+
+			continue
+		}
+
+		if oneInstruction.IsFail() {
+			return fmt.Errorf("there was an error while executing a fail (index: %d): %w", idx, err)
+		}
+
+		if err != nil {
+			execution := oneInstruction.Execution()
+			if execution.IsCallable() {
+				return fmt.Errorf("there was an error while executing a callable (name: %s, instruction: %d): %w", execution.Callable(), idx, err)
+			}
+
+			appIndex := execution.Index()
+			moduleIndex := execution.Module().Index()
+			return fmt.Errorf("there was an error while executing an application (module: %d, application: %d, instruction: %d): %w", moduleIndex, appIndex, idx, err)
+		}
+	}
+
+	return nil
+}
+
+func (app *application) executeCondition(ctx context.Context, input []interface{}, values *frame, condition programs.Condition) (interface{}, error) {
+	value, err := app.executeValue(ctx, input, values, condition.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	isTrue, ok := value.(bool)
+	if !ok {
+		str := fmt.Sprintf("the condition's value was expected to be a bool, %T provided", value)
+		return nil, errors.New(str)
+	}
+
+	if isTrue && condition.HasThen() {
+		return isTrue, app.executeInstructions(ctx, input, values, condition.Then())
+	}
+
+	if !isTrue && condition.HasElse() {
+		return isTrue, app.executeInstructions(ctx, input, values, condition.Else())
+	}
+
+	return isTrue, nil
+}
+
+func (app *application) executeTry(ctx context.Context, input []interface{}, values *frame, try programs.Try) (interface{}, error) {
+	if !try.HasInstructions() {
+		return nil, nil
+	}
+
+	err := app.executeInstructions(ctx, input, values, try.Instructions())
+	if err == nil {
+		return nil, nil
+	}
+
+	pErr, ok := caught(ctx, err)
+	if !ok {
+		return nil, err
+	}
+
+	if !try.HasCatch() {
+		return pErr, nil
+	}
+
+	variableNameStr := app.nameBytesToStringFn(try.Variable())
+	catch := createFrame(values)
+	catch.declare(variableNameStr)
+	catch.assign(variableNameStr, pErr)
+	err = app.executeInstructions(ctx, input, catch, try.Catch())
+
+	// the variables assigned in the catch branch, except its error, remain assigned after the try:
+	for name, oneValue := range catch.values {
+		if name == variableNameStr {
+			continue
+		}
+
+		values.assign(name, oneValue)
+	}
+
+	if err != nil {
+		return pErr, fmt.Errorf("there was an error in the catch branch of the try: %w", err)
+	}
+
+	return pErr, nil
+}
+
+func (app *application) executeFail(ctx context.Context, input []interface{}, values *frame, fail programs.Value) error {
+	value, err := app.executeValue(ctx, input, values, fail)
+	if err != nil {
+		return err
+	}
+
+	switch casted := value.(type) {
+	case *Error:
+		// failing with a caught error raises it again, as it was raised:
+		return casted
+	case []byte:
+		return errors.New(string(casted))
+	case string:
+		return errors.New(casted)
+	}
+
+	return errors.New(fmt.Sprintf("%v", value))
+}
+
+func (app *application) executeLoop(ctx context.Context, input []interface{}, values *frame, loop programs.Loop) (interface{}, error) {
+	outputs := []interface{}{}
+	if loop.HasItem() {
+		value, err := app.executeValue(ctx, input, values, loop.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		list, ok := value.([]interface{})
+		if !ok {
+			str := fmt.Sprintf("the loop's value was expected to be a list ([]interface{}), %T provided", value)
+			return nil, errors.New(str)
+		}
+
+		itemNameStr := app.nameBytesToStringFn(loop.Item())
+		for idx, oneItem := range list {
+			iteration := createFrame(values)
+			iteration.declare(itemNameStr)
+			iteration.assign(itemNameStr, oneItem)
+			outputs, err = app.executeIteration(ctx, input, iteration, loop, uint(idx), outputs)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return outputs, nil
+	}
+
+	maximum := *loop.Maximum()
+	for idx := uint(0); ; idx++ {
+		value, err := app.executeValue(ctx, input, values, loop.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		isTrue, ok := value.(bool)
+		if !ok {
+			str := fmt.Sprintf("the loop's value was expected to be a bool, %T provided", value)
+			return nil, errors.New(str)
+		}
+
+		if !isTrue {
+			break
+		}
+
+		if idx >= maximum {
+			str := fmt.Sprintf("the loop's value was still true after its maximum amount of iterations (%d)", maximum)
+			return nil, errors.New(str)
+		}
+
+		outputs, err = app.executeIteration(ctx, input, createFrame(values), loop, idx, outputs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return outputs, nil
+}
+
+func (app *application) executeIteration(ctx context.Context, input []interface{}, iteration *frame, loop programs.Loop, index uint, outputs []interface{}) ([]interface{}, error) {
+	outputNameStr := ""
+	if loop.HasOutput() {
+		outputNameStr = app.nameBytesToStringFn(loop.Output())
+		iteration.declare(outputNameStr)
+	}
+
+	if loop.HasInstructions() {
+		err := app.executeInstructions(ctx, input, iteration, loop.Instructions())
+		if err != nil {
+			return nil, fmt.Errorf("there was an error in the loop's iteration (index: %d): %w", index, err)
+		}
+	}
+
+	if !loop.HasOutput() {
+		return outputs, nil
+	}
+
+	output, ok := iteration.local(outputNameStr)
+	if !ok {
+		str := fmt.Sprintf("the loop's output (name: %s) was not assigned in the iteration (index: %d)", outputNameStr, index)
+		return nil, errors.New(str)
+	}
+
+	return append(outputs, output), nil
+}
+
+func (app *application) executeValue(ctx context.Context, input []interface{}, values *frame, value programs.Value) (interface{}, error) {
+	if value.IsInput() {
+		pInputIndex := value.Input()
+		if *pInputIndex >= uint(len(input)) {
+			str := fmt.Sprintf("the requested input variable (index: %d) is undefined", *pInputIndex)
+			return nil, errors.New(str)
+		}
+
+		return input[*pInputIndex], nil
+	}
+
+	if value.IsConstant() {
+		return value.Constant(), nil
+	}
+
+	if value.IsLiteral() {
+		return value.Literal(), nil
+	}
+
+	if value.IsVariable() {
+		variableNameStr := app.nameBytesToStringFn(value.Variable())
+		if ins, ok := values.fetch(variableNameStr); ok {
+			return ins, nil
+		}
+
+		str := fmt.Sprintf("the variable (name: %s) has not been assigned", variableNameStr)
+		return nil, errors.New(str)
+	}
+
+	if value.IsLoop() {
+		return app.executeLoop(ctx, input, values, value.Loop())
+	}
+
+	if value.IsProgram() {
+		return &callable{
+			application: app,
+			program:     value.Program(),
+			frame:       values,
+		}, nil
+	}
+
+	execution := value.Execution()
+	return app.execute(ctx, input, values, execution)
+}
+
+func (app *application) executeInstruction(ctx context.Context, input []interface{}, values *frame, instruction programs.Instruction) (interface{}, map[uint]interface{}, error) {
+	if instruction.IsValue() {
+		value := instruction.Value()
+		if value.IsExecution() {
+			return app.executeWithParameters(ctx, input, values, value.Execution())
+		}
+
+		output, err := app.executeValue(ctx, input, values, value)
+		return output, nil, err
+	}
+
+	if instruction.IsCondition() {
+		output, err := app.executeCondition(ctx, input, values, instruction.Condition())
+		return output, nil, err
+	}
+
+	if instruction.IsLoop() {
+		output, err := app.executeLoop(ctx, input, values, instruction.Loop())
+		return output, nil, err
+	}
+
+	if instruction.IsTry() {
+		output, err := app.executeTry(ctx, input, values, instruction.Try())
+		return output, nil, err
+	}
+
+	if instruction.IsFail() {
+		return nil, nil, app.executeFail(ctx, input, values, instruction.Fail())
+	}
+
+	return app.executeWithParameters(ctx, input, values, instruction.Execution())
+}
+
+func (app *application) execute(ctx context.Context, input []interface{}, values *frame, execution programs.Application) (interface{}, error) {
+	output, _, err := app.executeWithParameters(ctx, input, values, execution)
+	return output, err
+}
+
+func (app *application) executeWithParameters(ctx context.Context, input []interface{}, values *frame, execution programs.Application) (interface{}, map[uint]interface{}, error) {
+	parameters := map[uint]interface{}{}
+	if execution.HasAttachments() {
+		attachments := execution.Attachments().List()
+		for _, oneAttachment := range attachments {
+			attachedValue := oneAttachment.Value()
+			ins, err := app.executeValue(ctx, input, values, attachedValue)
+			if err != nil {
+				return nil, parameters, err
+			}
+
+			local := oneAttachment.Local()
+			parameters[local] = ins
+		}
+	}
+
+	if execution.IsCallable() {
+		output, err := app.executeCallable(ctx, values, execution.Callable(), parameters)
+		return output, parameters, err
+	}
+
+	execFn := execution.Module().ContextFunc()
+	output, err := execFn(ctx, parameters)
+	return output, parameters, err
+}
+
+// executeCallable executes the callable assigned to the variable, returning its output directly when it declares only one
+func (app *application) executeCallable(ctx context.Context, values *frame, variable []byte, parameters map[uint]interface{}) (interface{}, error) {
+	variableNameStr := app.nameBytesToStringFn(variable)
+	value, ok := values.fetch(variableNameStr)
+	if !ok {
+		str := fmt.Sprintf("the callable (name: %s) has not been assigned", variableNameStr)
+		return nil, errors.New(str)
+	}
+
+	casted, ok := value.(*callable)
+	if !ok {
+		str := fmt.Sprintf("the variable (name: %s) was expected to contain a callable, %T provided", variableNameStr, value)
+		return nil, errors.New(str)
+	}
+
+	return casted.call(ctx, variableNameStr, parameters)
+}
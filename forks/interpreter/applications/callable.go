@@ -0,0 +1,104 @@
+package applications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/steve-care-software/interpreter/domain/programs"
+)
+
+// DefaultMaxCallDepth represents the maximum amount of nested callable executions when the context does not define one
+const DefaultMaxCallDepth = 512
+
+// ErrCallDepthExceeded is returned when the executions of callables are nested deeper than the maximum
+var ErrCallDepthExceeded = errors.New("the maximum call depth has been exceeded")
+
+type callDepthKey struct{}
+type maxCallDepthKey struct{}
+
+// callable represents a program assigned to a variable, along with the frame it was assigned in
+type callable struct {
+	application *application
+	program     programs.Program
+	frame       *frame
+}
+
+// WithMaxCallDepth returns a copy of the context whose callable executions cannot be nested deeper than the maximum
+func WithMaxCallDepth(ctx context.Context, max uint) context.Context {
+	return context.WithValue(ctx, maxCallDepthKey{}, max)
+}
+
+// ExecuteCallable executes a callable received as a value, such as the input of a module, with the provided input, returning its output directly when it declares only one
+func ExecuteCallable(ctx context.Context, value interface{}, input []interface{}) (interface{}, error) {
+	casted, ok := value.(*callable)
+	if !ok {
+		str := fmt.Sprintf("the value was expected to contain a callable, %T provided", value)
+		return nil, errors.New(str)
+	}
+
+	parameters := map[uint]interface{}{}
+	for idx, oneInput := range input {
+		parameters[uint(idx)] = oneInput
+	}
+
+	return casted.call(ctx, "value", parameters)
+}
+
+// IsCallable returns true if the value is a callable, false otherwise
+func IsCallable(value interface{}) bool {
+	_, ok := value.(*callable)
+	return ok
+}
+
+func (obj *callable) call(ctx context.Context, name string, parameters map[uint]interface{}) (interface{}, error) {
+	depth, max := callDepthFromContext(ctx)
+	if depth+1 > max {
+		return nil, fmt.Errorf("%w (maximum: %d, callable: %s)", ErrCallDepthExceeded, max, name)
+	}
+
+	inputs := [][]byte{}
+	if obj.program.HasInputs() {
+		inputs = obj.program.Inputs()
+	}
+
+	for index := range parameters {
+		if index >= uint(len(inputs)) {
+			str := fmt.Sprintf("the callable (name: %s) declares %d input(s), but a value is attached to the input (index: %d)", name, len(inputs), index)
+			return nil, errors.New(str)
+		}
+	}
+
+	input := []interface{}{}
+	for idx, oneInput := range inputs {
+		if ins, ok := parameters[uint(idx)]; ok {
+			input = append(input, ins)
+			continue
+		}
+
+		str := fmt.Sprintf("the callable (name: %s) requires the input (name: %s, index: %d), but no value is attached to it", name, oneInput, idx)
+		return nil, errors.New(str)
+	}
+
+	ctx = context.WithValue(ctx, callDepthKey{}, depth+1)
+	output, err := obj.application.executeProgram(ctx, input, createIsolatedFrame(obj.frame), obj.program)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 1 {
+		return output[0], nil
+	}
+
+	return output, nil
+}
+
+func callDepthFromContext(ctx context.Context) (uint, uint) {
+	max, ok := ctx.Value(maxCallDepthKey{}).(uint)
+	if !ok {
+		max = DefaultMaxCallDepth
+	}
+
+	depth, _ := ctx.Value(callDepthKey{}).(uint)
+	return depth, max
+}
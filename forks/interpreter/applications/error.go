@@ -0,0 +1,127 @@
+package applications
+
+import (
+	"context"
+	"errors"
+
+	"github.com/steve-care-software/interpreter/domain/programs"
+)
+
+type positionKey struct{}
+type uncaughtKey struct{}
+
+// position represents the index of an executed instruction, inside the instructions of its parent
+type position struct {
+	parent *position
+	index  uint
+}
+
+// hookError represents an error returned by a hook, which a try never catches
+type hookError struct {
+	err error
+}
+
+// Error returns the message of the hook's error
+func (obj *hookError) Error() string {
+	return obj.err.Error()
+}
+
+// Unwrap returns the hook's error
+func (obj *hookError) Unwrap() error {
+	return obj.err
+}
+
+// Error returns the message of the error
+func (obj *Error) Error() string {
+	return obj.Message
+}
+
+// Unwrap returns the error the instruction failed with
+func (obj *Error) Unwrap() error {
+	return obj.err
+}
+
+// WithUncaught returns a copy of the context whose try instructions never catch an error matching one of the errs
+func WithUncaught(ctx context.Context, errs ...error) context.Context {
+	uncaught, _ := ctx.Value(uncaughtKey{}).([]error)
+	out := append([]error{}, uncaught...)
+	for _, oneErr := range errs {
+		isContained := false
+		for _, oneUncaught := range uncaught {
+			if oneUncaught == oneErr {
+				isContained = true
+				break
+			}
+		}
+
+		if !isContained {
+			out = append(out, oneErr)
+		}
+	}
+
+	return context.WithValue(ctx, uncaughtKey{}, out)
+}
+
+func positionFromContext(ctx context.Context) *position {
+	pos, _ := ctx.Value(positionKey{}).(*position)
+	return pos
+}
+
+func createError(
+	ctx context.Context,
+	instruction programs.Instruction,
+	err error,
+) *Error {
+	indexes := []uint{}
+	for current := positionFromContext(ctx); current != nil; current = current.parent {
+		indexes = append([]uint{current.index}, indexes...)
+	}
+
+	out := Error{
+		Message:  err.Error(),
+		Position: indexes,
+		err:      err,
+	}
+
+	execution := executionFromInstruction(instruction)
+	if execution != nil {
+		out.Application = execution.Name()
+		if execution.IsModule() {
+			moduleIndex := execution.Module().Index()
+			out.Module = &moduleIndex
+			out.ModuleName = execution.ModuleName()
+		}
+	}
+
+	return &out
+}
+
+// caught returns the error a try catches, if the error is catchable
+func caught(ctx context.Context, err error) (*Error, bool) {
+	if ctx.Err() != nil {
+		return nil, false
+	}
+
+	var pHookErr *hookError
+	if errors.As(err, &pHookErr) {
+		return nil, false
+	}
+
+	if errors.Is(err, ErrCallDepthExceeded) {
+		return nil, false
+	}
+
+	uncaught, _ := ctx.Value(uncaughtKey{}).([]error)
+	for _, oneUncaught := range uncaught {
+		if errors.Is(err, oneUncaught) {
+			return nil, false
+		}
+	}
+
+	var pErr *Error
+	if !errors.As(err, &pErr) {
+		return nil, false
+	}
+
+	return pErr, true
+}
@@ -0,0 +1,74 @@
+package applications
+
+// frame represents the scope in which the variables of executed instructions are assigned
+type frame struct {
+	parent     *frame
+	isIsolated bool
+	names      map[string]bool
+	values     map[string]interface{}
+}
+
+func createFrame(
+	parent *frame,
+) *frame {
+	return createFrameInternally(parent, false)
+}
+
+// createIsolatedFrame creates a frame that reads the variables of its parents but never assigns them
+func createIsolatedFrame(
+	parent *frame,
+) *frame {
+	return createFrameInternally(parent, true)
+}
+
+func createFrameInternally(
+	parent *frame,
+	isIsolated bool,
+) *frame {
+	out := frame{
+		parent:     parent,
+		isIsolated: isIsolated,
+		names:      map[string]bool{},
+		values:     map[string]interface{}{},
+	}
+
+	return &out
+}
+
+// declare makes the name local to the frame, even if it is not yet assigned
+func (app *frame) declare(name string) {
+	app.names[name] = true
+}
+
+// local returns the value assigned to the name in the frame, without looking in its parents
+func (app *frame) local(name string) (interface{}, bool) {
+	value, ok := app.values[name]
+	return value, ok
+}
+
+// fetch returns the value assigned to the name in the frame or its nearest parent
+func (app *frame) fetch(name string) (interface{}, bool) {
+	for current := app; current != nil; current = current.parent {
+		if value, ok := current.values[name]; ok {
+			return value, true
+		}
+	}
+
+	return nil, false
+}
+
+// assign assigns the value in the frame owning the name, or in the frame itself if no frame owns it, without crossing an isolated frame
+func (app *frame) assign(name string, value interface{}) {
+	for current := app; current != nil; current = current.parent {
+		if _, ok := current.values[name]; ok || current.names[name] {
+			current.values[name] = value
+			return
+		}
+
+		if current.isIsolated {
+			break
+		}
+	}
+
+	app.values[name] = value
+}
@@ -0,0 +1,79 @@
+package applications
+
+import (
+	"context"
+	"time"
+
+	"github.com/steve-care-software/interpreter/domain/programs"
+)
+
+type hookKey struct{}
+type depthKey struct{}
+
+// WithHook returns a copy of the context whose program executions call the hook after every executed instruction
+func WithHook(ctx context.Context, hookFn HookFn) context.Context {
+	return context.WithValue(ctx, hookKey{}, hookFn)
+}
+
+func fromContext(ctx context.Context) (HookFn, uint) {
+	hookFn, ok := ctx.Value(hookKey{}).(HookFn)
+	if !ok {
+		return nil, 0
+	}
+
+	depth, _ := ctx.Value(depthKey{}).(uint)
+	return hookFn, depth
+}
+
+func createEvent(
+	depth uint,
+	index uint,
+	instruction programs.Instruction,
+	input map[uint]interface{},
+	output interface{},
+	err error,
+	duration time.Duration,
+) Event {
+	event := Event{
+		Depth:       depth,
+		Instruction: index,
+		Input:       input,
+		Output:      output,
+		Error:       err,
+		Duration:    duration,
+	}
+
+	if instruction.IsValue() {
+		event.Variable = instruction.Variable()
+	}
+
+	execution := executionFromInstruction(instruction)
+	if execution != nil {
+		event.Application = execution.Name()
+		if execution.IsModule() {
+			moduleIndex := execution.Module().Index()
+			event.Module = &moduleIndex
+			event.ModuleName = execution.ModuleName()
+		}
+	}
+
+	return event
+}
+
+// executionFromInstruction returns the application executed by the instruction, if any
+func executionFromInstruction(instruction programs.Instruction) programs.Application {
+	if instruction.IsValue() {
+		value := instruction.Value()
+		if value.IsExecution() {
+			return value.Execution()
+		}
+
+		return nil
+	}
+
+	if instruction.IsExecution() {
+		return instruction.Execution()
+	}
+
+	return nil
+}
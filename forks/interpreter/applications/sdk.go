@@ -0,0 +1,90 @@
+package applications
+
+import (
+	"context"
+	"time"
+
+	"github.com/steve-care-software/interpreter/domain/instructions"
+	"github.com/steve-care-software/interpreter/domain/programs"
+	"github.com/steve-care-software/interpreter/domain/programs/modules"
+)
+
+// NameBytesToString converts a name []byte to a string
+type NameBytesToString func(name []byte) string
+
+// ImportFn compiles the script at the path into a program, the context being the one passed to CompileContext
+type ImportFn func(ctx context.Context, path []byte) (programs.Program, error)
+
+// HookFn is called after every instruction executed with a context containing it, returning an error stops the execution
+type HookFn func(ctx context.Context, event Event) error
+
+// Event represents an executed instruction
+type Event struct {
+	Depth       uint
+	Instruction uint
+	Variable    []byte
+	Application []byte
+	Module      *uint
+	ModuleName  []byte
+	Input       map[uint]interface{}
+	Output      interface{}
+	Error       error
+	Duration    time.Duration
+}
+
+// Error represents an error raised by an executed instruction, which a try assigns to its variable when it catches it,
+// its position contains the index of the failing instruction inside each nested instructions, from the outermost
+type Error struct {
+	Message     string
+	Application []byte
+	Module      *uint
+	ModuleName  []byte
+	Position    []uint
+	err         error
+}
+
+// NewApplication creates a new application
+func NewApplication(
+	nameBytesToStringFn NameBytesToString,
+) Application {
+	return NewApplicationWithImportFn(nameBytesToStringFn, nil)
+}
+
+// NewApplicationWithImportFn creates a new application whose import instructions are compiled by the importFn
+func NewApplicationWithImportFn(
+	nameBytesToStringFn NameBytesToString,
+	importFn ImportFn,
+) Application {
+	builder := programs.NewBuilder()
+	instructionsBuilder := programs.NewInstructionsBuilder()
+	instructionBuilder := programs.NewInstructionBuilder()
+	applicationBuilder := programs.NewApplicationBuilder()
+	attachmentsBuilder := programs.NewAttachmentsBuilder()
+	attachmentBuilder := programs.NewAttachmentBuilder()
+	valueBuilder := programs.NewValueBuilder()
+	conditionBuilder := programs.NewConditionBuilder()
+	loopBuilder := programs.NewLoopBuilder()
+	tryBuilder := programs.NewTryBuilder()
+	return createApplication(
+		builder,
+		instructionsBuilder,
+		instructionBuilder,
+		applicationBuilder,
+		attachmentsBuilder,
+		attachmentBuilder,
+		valueBuilder,
+		conditionBuilder,
+		loopBuilder,
+		tryBuilder,
+		nameBytesToStringFn,
+		importFn,
+	)
+}
+
+// Application represents a program application
+type Application interface {
+	Compile(modules modules.Modules, instructions instructions.Instructions) (programs.Program, error)
+	CompileContext(ctx context.Context, modules modules.Modules, instructions instructions.Instructions) (programs.Program, error)
+	Execute(input []interface{}, program programs.Program) ([]interface{}, error)
+	ExecuteContext(ctx context.Context, input []interface{}, program programs.Program) ([]interface{}, error)
+}
@@ -0,0 +1,74 @@
+package instructions
+
+type condition struct {
+	variable []byte
+	then     Instructions
+	els      Instructions
+}
+
+func createCondition(
+	variable []byte,
+) Condition {
+	return createConditionInternally(variable, nil, nil)
+}
+
+func createConditionWithThen(
+	variable []byte,
+	then Instructions,
+) Condition {
+	return createConditionInternally(variable, then, nil)
+}
+
+func createConditionWithElse(
+	variable []byte,
+	els Instructions,
+) Condition {
+	return createConditionInternally(variable, nil, els)
+}
+
+func createConditionWithThenAndElse(
+	variable []byte,
+	then Instructions,
+	els Instructions,
+) Condition {
+	return createConditionInternally(variable, then, els)
+}
+
+func createConditionInternally(
+	variable []byte,
+	then Instructions,
+	els Instructions,
+) Condition {
+	out := condition{
+		variable: variable,
+		then:     then,
+		els:      els,
+	}
+
+	return &out
+}
+
+// Variable returns the variable
+func (obj *condition) Variable() []byte {
+	return obj.variable
+}
+
+// HasThen returns true if there is a then branch, false otherwise
+func (obj *condition) HasThen() bool {
+	return obj.then != nil
+}
+
+// Then returns the then branch, if any
+func (obj *condition) Then() Instructions {
+	return obj.then
+}
+
+// HasElse returns true if there is an else branch, false otherwise
+func (obj *condition) HasElse() bool {
+	return obj.els != nil
+}
+
+// Else returns the else branch, if any
+func (obj *condition) Else() Instructions {
+	return obj.els
+}
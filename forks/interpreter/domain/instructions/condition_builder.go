@@ -0,0 +1,67 @@
+package instructions
+
+import "errors"
+
+type conditionBuilder struct {
+	variable []byte
+	then     Instructions
+	els      Instructions
+}
+
+func createConditionBuilder() ConditionBuilder {
+	out := conditionBuilder{
+		variable: nil,
+		then:     nil,
+		els:      nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *conditionBuilder) Create() ConditionBuilder {
+	return createConditionBuilder()
+}
+
+// WithVariable adds a variable to the builder
+func (app *conditionBuilder) WithVariable(variable []byte) ConditionBuilder {
+	app.variable = variable
+	return app
+}
+
+// WithThen adds a then branch to the builder
+func (app *conditionBuilder) WithThen(then Instructions) ConditionBuilder {
+	app.then = then
+	return app
+}
+
+// WithElse adds an else branch to the builder
+func (app *conditionBuilder) WithElse(els Instructions) ConditionBuilder {
+	app.els = els
+	return app
+}
+
+// Now builds a new Condition instance
+func (app *conditionBuilder) Now() (Condition, error) {
+	if app.variable != nil && len(app.variable) <= 0 {
+		app.variable = nil
+	}
+
+	if app.variable == nil {
+		return nil, errors.New("the variable is mandatory in order to build a Condition instance")
+	}
+
+	if app.then != nil && app.els != nil {
+		return createConditionWithThenAndElse(app.variable, app.then, app.els), nil
+	}
+
+	if app.then != nil {
+		return createConditionWithThen(app.variable, app.then), nil
+	}
+
+	if app.els != nil {
+		return createConditionWithElse(app.variable, app.els), nil
+	}
+
+	return createCondition(app.variable), nil
+}
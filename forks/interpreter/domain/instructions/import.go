@@ -0,0 +1,28 @@
+package instructions
+
+type importIns struct {
+	path     []byte
+	variable []byte
+}
+
+func createImport(
+	path []byte,
+	variable []byte,
+) Import {
+	out := importIns{
+		path:     path,
+		variable: variable,
+	}
+
+	return &out
+}
+
+// Path returns the path of the imported script
+func (obj *importIns) Path() []byte {
+	return obj.path
+}
+
+// Variable returns the variable the imported script is assigned to
+func (obj *importIns) Variable() []byte {
+	return obj.variable
+}
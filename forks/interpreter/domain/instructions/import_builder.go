@@ -0,0 +1,55 @@
+package instructions
+
+import "errors"
+
+type importBuilder struct {
+	path     []byte
+	variable []byte
+}
+
+func createImportBuilder() ImportBuilder {
+	out := importBuilder{
+		path:     nil,
+		variable: nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *importBuilder) Create() ImportBuilder {
+	return createImportBuilder()
+}
+
+// WithPath adds a path to the builder
+func (app *importBuilder) WithPath(path []byte) ImportBuilder {
+	app.path = path
+	return app
+}
+
+// WithVariable adds a variable to the builder
+func (app *importBuilder) WithVariable(variable []byte) ImportBuilder {
+	app.variable = variable
+	return app
+}
+
+// Now builds a new Import instance
+func (app *importBuilder) Now() (Import, error) {
+	if app.path != nil && len(app.path) <= 0 {
+		app.path = nil
+	}
+
+	if app.path == nil {
+		return nil, errors.New("the path is mandatory in order to build an Import instance")
+	}
+
+	if app.variable != nil && len(app.variable) <= 0 {
+		app.variable = nil
+	}
+
+	if app.variable == nil {
+		return nil, errors.New("the variable is mandatory in order to build an Import instance")
+	}
+
+	return createImport(app.path, app.variable), nil
+}
@@ -0,0 +1,228 @@
+package instructions
+
+import (
+	"github.com/steve-care-software/interpreter/domain/instructions/applications"
+	"github.com/steve-care-software/interpreter/domain/instructions/attachments"
+	"github.com/steve-care-software/interpreter/domain/instructions/modules"
+	"github.com/steve-care-software/interpreter/domain/instructions/parameters"
+)
+
+type instruction struct {
+	module      modules.Module
+	application applications.Application
+	parameter   parameters.Parameter
+	assignment  Assignment
+	attachment  attachments.Attachment
+	execution   []byte
+	condition   Condition
+	loop        Loop
+	importIns   Import
+	tryIns      Try
+	fail        []byte
+}
+
+func createInstructionWithModule(
+	module modules.Module,
+) Instruction {
+	return createInstructionInternally(module, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+}
+
+func createInstructionWithApplication(
+	application applications.Application,
+) Instruction {
+	return createInstructionInternally(nil, application, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+}
+
+func createInstructionWithParameter(
+	parameter parameters.Parameter,
+) Instruction {
+	return createInstructionInternally(nil, nil, parameter, nil, nil, nil, nil, nil, nil, nil, nil)
+}
+
+func createInstructionWithAssignment(
+	assignment Assignment,
+) Instruction {
+	return createInstructionInternally(nil, nil, nil, assignment, nil, nil, nil, nil, nil, nil, nil)
+}
+
+func createInstructionWithAttachment(
+	attachment attachments.Attachment,
+) Instruction {
+	return createInstructionInternally(nil, nil, nil, nil, attachment, nil, nil, nil, nil, nil, nil)
+}
+
+func createInstructionWithExecution(
+	execution []byte,
+) Instruction {
+	return createInstructionInternally(nil, nil, nil, nil, nil, execution, nil, nil, nil, nil, nil)
+}
+
+func createInstructionWithCondition(
+	condition Condition,
+) Instruction {
+	return createInstructionInternally(nil, nil, nil, nil, nil, nil, condition, nil, nil, nil, nil)
+}
+
+func createInstructionWithLoop(
+	loop Loop,
+) Instruction {
+	return createInstructionInternally(nil, nil, nil, nil, nil, nil, nil, loop, nil, nil, nil)
+}
+
+func createInstructionWithImport(
+	importIns Import,
+) Instruction {
+	return createInstructionInternally(nil, nil, nil, nil, nil, nil, nil, nil, importIns, nil, nil)
+}
+
+func createInstructionWithTry(
+	tryIns Try,
+) Instruction {
+	return createInstructionInternally(nil, nil, nil, nil, nil, nil, nil, nil, nil, tryIns, nil)
+}
+
+func createInstructionWithFail(
+	fail []byte,
+) Instruction {
+	return createInstructionInternally(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fail)
+}
+
+func createInstructionInternally(
+	module modules.Module,
+	application applications.Application,
+	parameter parameters.Parameter,
+	assignment Assignment,
+	attachment attachments.Attachment,
+	execution []byte,
+	condition Condition,
+	loop Loop,
+	importIns Import,
+	tryIns Try,
+	fail []byte,
+) Instruction {
+	out := instruction{
+		module:      module,
+		application: application,
+		parameter:   parameter,
+		assignment:  assignment,
+		attachment:  attachment,
+		execution:   execution,
+		condition:   condition,
+		loop:        loop,
+		importIns:   importIns,
+		tryIns:      tryIns,
+		fail:        fail,
+	}
+
+	return &out
+}
+
+// IsModule returns true if there is a module, false otherwise
+func (obj *instruction) IsModule() bool {
+	return obj.module != nil
+}
+
+// Module returns the module, if any
+func (obj *instruction) Module() modules.Module {
+	return obj.module
+}
+
+// IsApplication returns true if there is an application, false otherwise
+func (obj *instruction) IsApplication() bool {
+	return obj.application != nil
+}
+
+// Application returns the application, if any
+func (obj *instruction) Application() applications.Application {
+	return obj.application
+}
+
+// IsParameter returns true if there is a parameter, false otherwise
+func (obj *instruction) IsParameter() bool {
+	return obj.parameter != nil
+}
+
+// Parameter returns the parameter, if any
+func (obj *instruction) Parameter() parameters.Parameter {
+	return obj.parameter
+}
+
+// IsAssignment returns true if there is an assignment, false otherwise
+func (obj *instruction) IsAssignment() bool {
+	return obj.assignment != nil
+}
+
+// Assignment returns the assignment, if any
+func (obj *instruction) Assignment() Assignment {
+	return obj.assignment
+}
+
+// IsAttachment returns true if there is an attachment, false otherwise
+func (obj *instruction) IsAttachment() bool {
+	return obj.attachment != nil
+}
+
+// Attachment returns the attachment, if any
+func (obj *instruction) Attachment() attachments.Attachment {
+	return obj.attachment
+}
+
+// IsExecution returns true if there is an execution, false otherwise
+func (obj *instruction) IsExecution() bool {
+	return obj.execution != nil
+}
+
+// Execution returns the execution, if any
+func (obj *instruction) Execution() []byte {
+	return obj.execution
+}
+
+// IsCondition returns true if there is a condition, false otherwise
+func (obj *instruction) IsCondition() bool {
+	return obj.condition != nil
+}
+
+// Condition returns the condition, if any
+func (obj *instruction) Condition() Condition {
+	return obj.condition
+}
+
+// IsLoop returns true if there is a loop, false otherwise
+func (obj *instruction) IsLoop() bool {
+	return obj.loop != nil
+}
+
+// Loop returns the loop, if any
+func (obj *instruction) Loop() Loop {
+	return obj.loop
+}
+
+// IsImport returns true if there is an import, false otherwise
+func (obj *instruction) IsImport() bool {
+	return obj.importIns != nil
+}
+
+// Import returns the import, if any
+func (obj *instruction) Import() Import {
+	return obj.importIns
+}
+
+// IsTry returns true if there is a try, false otherwise
+func (obj *instruction) IsTry() bool {
+	return obj.tryIns != nil
+}
+
+// Try returns the try, if any
+func (obj *instruction) Try() Try {
+	return obj.tryIns
+}
+
+// IsFail returns true if there is a fail, false otherwise
+func (obj *instruction) IsFail() bool {
+	return obj.fail != nil
+}
+
+// Fail returns the variable containing the message of the fail, if any
+func (obj *instruction) Fail() []byte {
+	return obj.fail
+}
@@ -0,0 +1,166 @@
+package instructions
+
+import (
+	"errors"
+
+	"github.com/steve-care-software/interpreter/domain/instructions/applications"
+	"github.com/steve-care-software/interpreter/domain/instructions/attachments"
+	"github.com/steve-care-software/interpreter/domain/instructions/modules"
+	"github.com/steve-care-software/interpreter/domain/instructions/parameters"
+)
+
+type instructionBuilder struct {
+	module      modules.Module
+	application applications.Application
+	parameter   parameters.Parameter
+	assignment  Assignment
+	attachment  attachments.Attachment
+	execution   []byte
+	condition   Condition
+	loop        Loop
+	importIns   Import
+	tryIns      Try
+	fail        []byte
+}
+
+func createInstructionBuilder() InstructionBuilder {
+	out := instructionBuilder{
+		module:      nil,
+		application: nil,
+		parameter:   nil,
+		assignment:  nil,
+		attachment:  nil,
+		execution:   nil,
+		condition:   nil,
+		loop:        nil,
+		importIns:   nil,
+		tryIns:      nil,
+		fail:        nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *instructionBuilder) Create() InstructionBuilder {
+	return createInstructionBuilder()
+}
+
+// WithModule adds a module to the builder
+func (app *instructionBuilder) WithModule(module modules.Module) InstructionBuilder {
+	app.module = module
+	return app
+}
+
+// WithApplication adds an application to the builder
+func (app *instructionBuilder) WithApplication(application applications.Application) InstructionBuilder {
+	app.application = application
+	return app
+}
+
+// WithParameter adds a parameter to the builder
+func (app *instructionBuilder) WithParameter(parameter parameters.Parameter) InstructionBuilder {
+	app.parameter = parameter
+	return app
+}
+
+// WithAssignment adds an assignment to the builder
+func (app *instructionBuilder) WithAssignment(assignment Assignment) InstructionBuilder {
+	app.assignment = assignment
+	return app
+}
+
+// WithAttachment adds an attachment to the builder
+func (app *instructionBuilder) WithAttachment(attachment attachments.Attachment) InstructionBuilder {
+	app.attachment = attachment
+	return app
+}
+
+// WithExecution adds an execution to the builder
+func (app *instructionBuilder) WithExecution(execution []byte) InstructionBuilder {
+	app.execution = execution
+	return app
+}
+
+// WithCondition adds a condition to the builder
+func (app *instructionBuilder) WithCondition(condition Condition) InstructionBuilder {
+	app.condition = condition
+	return app
+}
+
+// WithLoop adds a loop to the builder
+func (app *instructionBuilder) WithLoop(loop Loop) InstructionBuilder {
+	app.loop = loop
+	return app
+}
+
+// WithImport adds an import to the builder
+func (app *instructionBuilder) WithImport(importIns Import) InstructionBuilder {
+	app.importIns = importIns
+	return app
+}
+
+// WithTry adds a try to the builder
+func (app *instructionBuilder) WithTry(tryIns Try) InstructionBuilder {
+	app.tryIns = tryIns
+	return app
+}
+
+// WithFail adds the variable containing the message of a fail to the builder
+func (app *instructionBuilder) WithFail(fail []byte) InstructionBuilder {
+	app.fail = fail
+	return app
+}
+
+// Now builds a new Instruction instance
+func (app *instructionBuilder) Now() (Instruction, error) {
+	if app.fail != nil && len(app.fail) <= 0 {
+		app.fail = nil
+	}
+
+	if app.module != nil {
+		return createInstructionWithModule(app.module), nil
+	}
+
+	if app.application != nil {
+		return createInstructionWithApplication(app.application), nil
+	}
+
+	if app.parameter != nil {
+		return createInstructionWithParameter(app.parameter), nil
+	}
+
+	if app.assignment != nil {
+		return createInstructionWithAssignment(app.assignment), nil
+	}
+
+	if app.attachment != nil {
+		return createInstructionWithAttachment(app.attachment), nil
+	}
+
+	if app.execution != nil {
+		return createInstructionWithExecution(app.execution), nil
+	}
+
+	if app.condition != nil {
+		return createInstructionWithCondition(app.condition), nil
+	}
+
+	if app.loop != nil {
+		return createInstructionWithLoop(app.loop), nil
+	}
+
+	if app.importIns != nil {
+		return createInstructionWithImport(app.importIns), nil
+	}
+
+	if app.tryIns != nil {
+		return createInstructionWithTry(app.tryIns), nil
+	}
+
+	if app.fail != nil {
+		return createInstructionWithFail(app.fail), nil
+	}
+
+	return nil, errors.New("the Instruction is invalid")
+}
@@ -0,0 +1,89 @@
+package instructions
+
+type loop struct {
+	variable     []byte
+	item         []byte
+	pMaximum     *uint
+	instructions Instructions
+}
+
+func createLoopWithItem(
+	variable []byte,
+	item []byte,
+) Loop {
+	return createLoopInternally(variable, item, nil, nil)
+}
+
+func createLoopWithItemAndInstructions(
+	variable []byte,
+	item []byte,
+	instructions Instructions,
+) Loop {
+	return createLoopInternally(variable, item, nil, instructions)
+}
+
+func createLoopWithMaximum(
+	variable []byte,
+	pMaximum *uint,
+) Loop {
+	return createLoopInternally(variable, nil, pMaximum, nil)
+}
+
+func createLoopWithMaximumAndInstructions(
+	variable []byte,
+	pMaximum *uint,
+	instructions Instructions,
+) Loop {
+	return createLoopInternally(variable, nil, pMaximum, instructions)
+}
+
+func createLoopInternally(
+	variable []byte,
+	item []byte,
+	pMaximum *uint,
+	instructions Instructions,
+) Loop {
+	out := loop{
+		variable:     variable,
+		item:         item,
+		pMaximum:     pMaximum,
+		instructions: instructions,
+	}
+
+	return &out
+}
+
+// Variable returns the variable
+func (obj *loop) Variable() []byte {
+	return obj.variable
+}
+
+// HasItem returns true if there is an item, false otherwise
+func (obj *loop) HasItem() bool {
+	return obj.item != nil
+}
+
+// Item returns the item, if any
+func (obj *loop) Item() []byte {
+	return obj.item
+}
+
+// HasMaximum returns true if there is a maximum, false otherwise
+func (obj *loop) HasMaximum() bool {
+	return obj.pMaximum != nil
+}
+
+// Maximum returns the maximum, if any
+func (obj *loop) Maximum() *uint {
+	return obj.pMaximum
+}
+
+// HasInstructions returns true if there is instructions, false otherwise
+func (obj *loop) HasInstructions() bool {
+	return obj.instructions != nil
+}
+
+// Instructions returns the instructions, if any
+func (obj *loop) Instructions() Instructions {
+	return obj.instructions
+}
@@ -0,0 +1,87 @@
+package instructions
+
+import "errors"
+
+type loopBuilder struct {
+	variable     []byte
+	item         []byte
+	pMaximum     *uint
+	instructions Instructions
+}
+
+func createLoopBuilder() LoopBuilder {
+	out := loopBuilder{
+		variable:     nil,
+		item:         nil,
+		pMaximum:     nil,
+		instructions: nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *loopBuilder) Create() LoopBuilder {
+	return createLoopBuilder()
+}
+
+// WithVariable adds a variable to the builder
+func (app *loopBuilder) WithVariable(variable []byte) LoopBuilder {
+	app.variable = variable
+	return app
+}
+
+// WithItem adds an item to the builder
+func (app *loopBuilder) WithItem(item []byte) LoopBuilder {
+	app.item = item
+	return app
+}
+
+// WithMaximum adds a maximum to the builder
+func (app *loopBuilder) WithMaximum(maximum uint) LoopBuilder {
+	app.pMaximum = &maximum
+	return app
+}
+
+// WithInstructions add instructions to the builder
+func (app *loopBuilder) WithInstructions(instructions Instructions) LoopBuilder {
+	app.instructions = instructions
+	return app
+}
+
+// Now builds a new Loop instance
+func (app *loopBuilder) Now() (Loop, error) {
+	if app.variable != nil && len(app.variable) <= 0 {
+		app.variable = nil
+	}
+
+	if app.variable == nil {
+		return nil, errors.New("the variable is mandatory in order to build a Loop instance")
+	}
+
+	if app.item != nil && len(app.item) <= 0 {
+		app.item = nil
+	}
+
+	if app.item != nil && app.pMaximum != nil {
+		return nil, errors.New("the Loop cannot contain both an item and a maximum")
+	}
+
+	if app.item != nil && app.instructions != nil {
+		return createLoopWithItemAndInstructions(app.variable, app.item, app.instructions), nil
+	}
+
+	if app.item != nil {
+		return createLoopWithItem(app.variable, app.item), nil
+	}
+
+	if app.pMaximum != nil && app.instructions != nil {
+		return createLoopWithMaximumAndInstructions(app.variable, app.pMaximum, app.instructions), nil
+	}
+
+	if app.pMaximum != nil {
+		return createLoopWithMaximum(app.variable, app.pMaximum), nil
+	}
+
+	return nil, errors.New("the Loop must contain either an item or a maximum")
+}
@@ -0,0 +1,220 @@
+package instructions
+
+import (
+	"github.com/steve-care-software/interpreter/domain/instructions/applications"
+	"github.com/steve-care-software/interpreter/domain/instructions/attachments"
+	"github.com/steve-care-software/interpreter/domain/instructions/modules"
+	"github.com/steve-care-software/interpreter/domain/instructions/parameters"
+)
+
+// NewBuilder creates a new builder instance
+func NewBuilder() Builder {
+	return createBuilder()
+}
+
+// NewInstructionBuilder creates a new instruction builder
+func NewInstructionBuilder() InstructionBuilder {
+	return createInstructionBuilder()
+}
+
+// NewAssignmentBuilder creates a new assignment builder
+func NewAssignmentBuilder() AssignmentBuilder {
+	return createAssignmentBuilder()
+}
+
+// NewConditionBuilder creates a new condition builder
+func NewConditionBuilder() ConditionBuilder {
+	return createConditionBuilder()
+}
+
+// NewImportBuilder creates a new import builder
+func NewImportBuilder() ImportBuilder {
+	return createImportBuilder()
+}
+
+// NewLoopBuilder creates a new loop builder
+func NewLoopBuilder() LoopBuilder {
+	return createLoopBuilder()
+}
+
+// NewTryBuilder creates a new try builder
+func NewTryBuilder() TryBuilder {
+	return createTryBuilder()
+}
+
+// NewValueBuilder creates a new value builder
+func NewValueBuilder() ValueBuilder {
+	return createValueBuilder()
+}
+
+// Builder represents instructions builder
+type Builder interface {
+	Create() Builder
+	WithList(instructions []Instruction) Builder
+	WithRemaining(remaining []byte) Builder
+	Now() (Instructions, error)
+}
+
+// Instructions represents instructions
+type Instructions interface {
+	List() []Instruction
+	HasRemaining() bool
+	Remaining() []byte
+}
+
+// InstructionBuilder represents an instruction builder
+type InstructionBuilder interface {
+	Create() InstructionBuilder
+	WithModule(module modules.Module) InstructionBuilder
+	WithApplication(application applications.Application) InstructionBuilder
+	WithParameter(parameter parameters.Parameter) InstructionBuilder
+	WithAssignment(assignment Assignment) InstructionBuilder
+	WithAttachment(attachment attachments.Attachment) InstructionBuilder
+	WithExecution(execution []byte) InstructionBuilder
+	WithCondition(condition Condition) InstructionBuilder
+	WithLoop(loop Loop) InstructionBuilder
+	WithImport(importIns Import) InstructionBuilder
+	WithTry(tryIns Try) InstructionBuilder
+	WithFail(fail []byte) InstructionBuilder
+	Now() (Instruction, error)
+}
+
+// Instruction represents an instruction
+type Instruction interface {
+	IsModule() bool
+	Module() modules.Module
+	IsApplication() bool
+	Application() applications.Application
+	IsParameter() bool
+	Parameter() parameters.Parameter
+	IsAssignment() bool
+	Assignment() Assignment
+	IsAttachment() bool
+	Attachment() attachments.Attachment
+	IsExecution() bool
+	Execution() []byte
+	IsCondition() bool
+	Condition() Condition
+	IsLoop() bool
+	Loop() Loop
+	IsImport() bool
+	Import() Import
+	IsTry() bool
+	Try() Try
+	IsFail() bool
+	Fail() []byte
+}
+
+// ImportBuilder represents an import builder
+type ImportBuilder interface {
+	Create() ImportBuilder
+	WithPath(path []byte) ImportBuilder
+	WithVariable(variable []byte) ImportBuilder
+	Now() (Import, error)
+}
+
+// Import represents the import of the script at a path, assigned to a variable
+type Import interface {
+	Path() []byte
+	Variable() []byte
+}
+
+// ConditionBuilder represents a condition builder
+type ConditionBuilder interface {
+	Create() ConditionBuilder
+	WithVariable(variable []byte) ConditionBuilder
+	WithThen(then Instructions) ConditionBuilder
+	WithElse(els Instructions) ConditionBuilder
+	Now() (Condition, error)
+}
+
+// Condition represents a condition executing its then branch when its variable is true, its else branch otherwise
+type Condition interface {
+	Variable() []byte
+	HasThen() bool
+	Then() Instructions
+	HasElse() bool
+	Else() Instructions
+}
+
+// LoopBuilder represents a loop builder
+type LoopBuilder interface {
+	Create() LoopBuilder
+	WithVariable(variable []byte) LoopBuilder
+	WithItem(item []byte) LoopBuilder
+	WithMaximum(maximum uint) LoopBuilder
+	WithInstructions(instructions Instructions) LoopBuilder
+	Now() (Loop, error)
+}
+
+// Loop represents a loop, executing its instructions for each item of the list contained in its variable,
+// or while its variable is true without exceeding its maximum amount of iterations
+type Loop interface {
+	Variable() []byte
+	HasItem() bool
+	Item() []byte
+	HasMaximum() bool
+	Maximum() *uint
+	HasInstructions() bool
+	Instructions() Instructions
+}
+
+// TryBuilder represents a try builder
+type TryBuilder interface {
+	Create() TryBuilder
+	WithInstructions(instructions Instructions) TryBuilder
+	WithVariable(variable []byte) TryBuilder
+	WithCatch(catch Instructions) TryBuilder
+	Now() (Try, error)
+}
+
+// Try represents a try, executing its catch branch with the error assigned to its variable when its instructions fail
+type Try interface {
+	HasInstructions() bool
+	Instructions() Instructions
+	Variable() []byte
+	HasCatch() bool
+	Catch() Instructions
+}
+
+// AssignmentBuilder represents an assignment builder
+type AssignmentBuilder interface {
+	Create() AssignmentBuilder
+	WithVariable(variable []byte) AssignmentBuilder
+	WithValue(value Value) AssignmentBuilder
+	Now() (Assignment, error)
+}
+
+// Assignment represents an assignment
+type Assignment interface {
+	Variable() []byte
+	Value() Value
+}
+
+// ValueBuilder represents a value builder
+type ValueBuilder interface {
+	Create() ValueBuilder
+	WithVariable(variable []byte) ValueBuilder
+	WithConstant(constant []byte) ValueBuilder
+	WithLiteral(literal interface{}) ValueBuilder
+	WithInstructions(instructions Instructions) ValueBuilder
+	WithExecution(execution []byte) ValueBuilder
+	WithLoop(loop Loop) ValueBuilder
+	Now() (Value, error)
+}
+
+// Value represents a value
+type Value interface {
+	IsVariable() bool
+	Variable() []byte
+	IsConstant() bool
+	Constant() []byte
+	IsLiteral() bool
+	Literal() interface{}
+	IsInstructions() bool
+	Instructions() Instructions
+	IsExecution() bool
+	Execution() []byte
+	IsLoop() bool
+	Loop() Loop
+}
@@ -0,0 +1,74 @@
+package instructions
+
+type try struct {
+	instructions Instructions
+	variable     []byte
+	catch        Instructions
+}
+
+func createTry(
+	variable []byte,
+) Try {
+	return createTryInternally(nil, variable, nil)
+}
+
+func createTryWithInstructions(
+	instructions Instructions,
+	variable []byte,
+) Try {
+	return createTryInternally(instructions, variable, nil)
+}
+
+func createTryWithCatch(
+	variable []byte,
+	catch Instructions,
+) Try {
+	return createTryInternally(nil, variable, catch)
+}
+
+func createTryWithInstructionsAndCatch(
+	instructions Instructions,
+	variable []byte,
+	catch Instructions,
+) Try {
+	return createTryInternally(instructions, variable, catch)
+}
+
+func createTryInternally(
+	instructions Instructions,
+	variable []byte,
+	catch Instructions,
+) Try {
+	out := try{
+		instructions: instructions,
+		variable:     variable,
+		catch:        catch,
+	}
+
+	return &out
+}
+
+// HasInstructions returns true if there is instructions, false otherwise
+func (obj *try) HasInstructions() bool {
+	return obj.instructions != nil
+}
+
+// Instructions returns the instructions, if any
+func (obj *try) Instructions() Instructions {
+	return obj.instructions
+}
+
+// Variable returns the variable the error is assigned to
+func (obj *try) Variable() []byte {
+	return obj.variable
+}
+
+// HasCatch returns true if there is a catch branch, false otherwise
+func (obj *try) HasCatch() bool {
+	return obj.catch != nil
+}
+
+// Catch returns the catch branch, if any
+func (obj *try) Catch() Instructions {
+	return obj.catch
+}
@@ -0,0 +1,126 @@
+package instructions
+
+type value struct {
+	variable     []byte
+	constant     []byte
+	literal      interface{}
+	instructions Instructions
+	execution    []byte
+	loop         Loop
+}
+
+func createValueWithVariable(
+	variable []byte,
+) Value {
+	return createValueInternally(variable, nil, nil, nil, nil, nil)
+}
+
+func createValueWithConstant(
+	constant []byte,
+) Value {
+	return createValueInternally(nil, constant, nil, nil, nil, nil)
+}
+
+func createValueWithLiteral(
+	literal interface{},
+) Value {
+	return createValueInternally(nil, nil, literal, nil, nil, nil)
+}
+
+func createValueWithInstructions(
+	instructions Instructions,
+) Value {
+	return createValueInternally(nil, nil, nil, instructions, nil, nil)
+}
+
+func createValueWithExecution(
+	execution []byte,
+) Value {
+	return createValueInternally(nil, nil, nil, nil, execution, nil)
+}
+
+func createValueWithLoop(
+	loop Loop,
+) Value {
+	return createValueInternally(nil, nil, nil, nil, nil, loop)
+}
+
+func createValueInternally(
+	variable []byte,
+	constant []byte,
+	literal interface{},
+	instructions Instructions,
+	execution []byte,
+	loop Loop,
+) Value {
+	out := value{
+		variable:     variable,
+		constant:     constant,
+		literal:      literal,
+		instructions: instructions,
+		execution:    execution,
+		loop:         loop,
+	}
+
+	return &out
+}
+
+// IsVariable returns true if there is a variable, false otherwise
+func (obj *value) IsVariable() bool {
+	return obj.variable != nil
+}
+
+// Variable returns the variable, if any
+func (obj *value) Variable() []byte {
+	return obj.variable
+}
+
+// IsConstant returns true if there is a constant, false otherwise
+func (obj *value) IsConstant() bool {
+	return obj.constant != nil
+}
+
+// Constant returns the constant, if any
+func (obj *value) Constant() []byte {
+	return obj.constant
+}
+
+// IsLiteral returns true if there is a literal, false otherwise
+func (obj *value) IsLiteral() bool {
+	return obj.literal != nil
+}
+
+// Literal returns the literal, if any
+func (obj *value) Literal() interface{} {
+	return obj.literal
+}
+
+// IsInstructions returns true if there is instructions, false otherwise
+func (obj *value) IsInstructions() bool {
+	return obj.instructions != nil
+}
+
+// Instructions returns the instructions, if any
+func (obj *value) Instructions() Instructions {
+	return obj.instructions
+}
+
+// IsExecution returns true if there is an execution, false otherwise
+func (obj *value) IsExecution() bool {
+	return obj.execution != nil
+}
+
+// Execution returns the execution, if any
+func (obj *value) Execution() []byte {
+	return obj.execution
+}
+
+// IsLoop returns true if there is a loop, false otherwise
+func (obj *value) IsLoop() bool {
+	return obj.loop != nil
+}
+
+// Loop returns the loop, if any
+func (obj *value) Loop() Loop {
+	return obj.loop
+}
@@ -0,0 +1,125 @@
+package programs
+
+import (
+	"github.com/steve-care-software/interpreter/domain/programs/modules"
+)
+
+type application struct {
+	index       uint
+	module      modules.Module
+	callable    []byte
+	attachments Attachments
+	name        []byte
+	moduleName  []byte
+}
+
+func createApplication(
+	index uint,
+	module modules.Module,
+	name []byte,
+	moduleName []byte,
+) Application {
+	return createApplicationInternally(index, module, nil, nil, name, moduleName)
+}
+
+func createApplicationWithAttachments(
+	index uint,
+	module modules.Module,
+	attachments Attachments,
+	name []byte,
+	moduleName []byte,
+) Application {
+	return createApplicationInternally(index, module, nil, attachments, name, moduleName)
+}
+
+func createApplicationWithCallable(
+	index uint,
+	callable []byte,
+	name []byte,
+) Application {
+	return createApplicationInternally(index, nil, callable, nil, name, nil)
+}
+
+func createApplicationWithCallableAndAttachments(
+	index uint,
+	callable []byte,
+	attachments Attachments,
+	name []byte,
+) Application {
+	return createApplicationInternally(index, nil, callable, attachments, name, nil)
+}
+
+func createApplicationInternally(
+	index uint,
+	module modules.Module,
+	callable []byte,
+	attachments Attachments,
+	name []byte,
+	moduleName []byte,
+) Application {
+	out := application{
+		index:       index,
+		module:      module,
+		callable:    callable,
+		attachments: attachments,
+		name:        name,
+		moduleName:  moduleName,
+	}
+
+	return &out
+}
+
+// Index returns the index
+func (obj *application) Index() uint {
+	return obj.index
+}
+
+// IsModule returns true if the application executes a module, false otherwise
+func (obj *application) IsModule() bool {
+	return obj.module != nil
+}
+
+// Module returns the module, if any
+func (obj *application) Module() modules.Module {
+	return obj.module
+}
+
+// IsCallable returns true if the application executes the callable assigned to a variable, false otherwise
+func (obj *application) IsCallable() bool {
+	return obj.callable != nil
+}
+
+// Callable returns the name of the variable containing the callable, if any
+func (obj *application) Callable() []byte {
+	return obj.callable
+}
+
+// HasAttachments returns true if there is attachments, false otherwise
+func (obj *application) HasAttachments() bool {
+	return obj.attachments != nil
+}
+
+// Attachments returns the attachments, if any
+func (obj *application) Attachments() Attachments {
+	return obj.attachments
+}
+
+// HasName returns true if the application's declared name is known, false otherwise
+func (obj *application) HasName() bool {
+	return obj.name != nil
+}
+
+// Name returns the application's declared name, if any
+func (obj *application) Name() []byte {
+	return obj.name
+}
+
+// HasModuleName returns true if the declared name of the module is known, false otherwise
+func (obj *application) HasModuleName() bool {
+	return obj.moduleName != nil
+}
+
+// ModuleName returns the declared name of the module, if any
+func (obj *application) ModuleName() []byte {
+	return obj.moduleName
+}
@@ -0,0 +1,111 @@
+package programs
+
+import (
+	"errors"
+
+	"github.com/steve-care-software/interpreter/domain/programs/modules"
+)
+
+type applicationBuilder struct {
+	pIndex      *uint
+	module      modules.Module
+	callable    []byte
+	attachments Attachments
+	name        []byte
+	moduleName  []byte
+}
+
+func createApplicationBuilder() ApplicationBuilder {
+	out := applicationBuilder{
+		pIndex:      nil,
+		module:      nil,
+		callable:    nil,
+		attachments: nil,
+		name:        nil,
+		moduleName:  nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *applicationBuilder) Create() ApplicationBuilder {
+	return createApplicationBuilder()
+}
+
+// WithIndex adds an index to the builder
+func (app *applicationBuilder) WithIndex(index uint) ApplicationBuilder {
+	app.pIndex = &index
+	return app
+}
+
+// WithModule adds a module to the builder
+func (app *applicationBuilder) WithModule(module modules.Module) ApplicationBuilder {
+	app.module = module
+	return app
+}
+
+// WithCallable adds the name of the variable containing the executed callable to the builder
+func (app *applicationBuilder) WithCallable(callable []byte) ApplicationBuilder {
+	app.callable = callable
+	return app
+}
+
+// WithAttachments add attachments to the builder
+func (app *applicationBuilder) WithAttachments(attachments Attachments) ApplicationBuilder {
+	app.attachments = attachments
+	return app
+}
+
+// WithName adds the application's declared name to the builder
+func (app *applicationBuilder) WithName(name []byte) ApplicationBuilder {
+	app.name = name
+	return app
+}
+
+// WithModuleName adds the declared name of the module to the builder
+func (app *applicationBuilder) WithModuleName(moduleName []byte) ApplicationBuilder {
+	app.moduleName = moduleName
+	return app
+}
+
+// Now builds a new Application instance
+func (app *applicationBuilder) Now() (Application, error) {
+	if app.pIndex == nil {
+		return nil, errors.New("the index is mandatory in order to build an Application instance")
+	}
+
+	if app.callable != nil && len(app.callable) <= 0 {
+		app.callable = nil
+	}
+
+	if app.name != nil && len(app.name) <= 0 {
+		app.name = nil
+	}
+
+	if app.moduleName != nil && len(app.moduleName) <= 0 {
+		app.moduleName = nil
+	}
+
+	if app.callable != nil {
+		if app.module != nil {
+			return nil, errors.New("the module and the callable cannot be both set in order to build an Application instance")
+		}
+
+		if app.attachments != nil {
+			return createApplicationWithCallableAndAttachments(*app.pIndex, app.callable, app.attachments, app.name), nil
+		}
+
+		return createApplicationWithCallable(*app.pIndex, app.callable, app.name), nil
+	}
+
+	if app.module == nil {
+		return nil, errors.New("the module or the callable is mandatory in order to build an Application instance")
+	}
+
+	if app.attachments != nil {
+		return createApplicationWithAttachments(*app.pIndex, app.module, app.attachments, app.name, app.moduleName), nil
+	}
+
+	return createApplication(*app.pIndex, app.module, app.name, app.moduleName), nil
+}
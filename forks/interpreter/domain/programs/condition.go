@@ -0,0 +1,74 @@
+package programs
+
+type condition struct {
+	value Value
+	then  Instructions
+	els   Instructions
+}
+
+func createCondition(
+	value Value,
+) Condition {
+	return createConditionInternally(value, nil, nil)
+}
+
+func createConditionWithThen(
+	value Value,
+	then Instructions,
+) Condition {
+	return createConditionInternally(value, then, nil)
+}
+
+func createConditionWithElse(
+	value Value,
+	els Instructions,
+) Condition {
+	return createConditionInternally(value, nil, els)
+}
+
+func createConditionWithThenAndElse(
+	value Value,
+	then Instructions,
+	els Instructions,
+) Condition {
+	return createConditionInternally(value, then, els)
+}
+
+func createConditionInternally(
+	value Value,
+	then Instructions,
+	els Instructions,
+) Condition {
+	out := condition{
+		value: value,
+		then:  then,
+		els:   els,
+	}
+
+	return &out
+}
+
+// Value returns the value
+func (obj *condition) Value() Value {
+	return obj.value
+}
+
+// HasThen returns true if there is a then branch, false otherwise
+func (obj *condition) HasThen() bool {
+	return obj.then != nil
+}
+
+// Then returns the then branch, if any
+func (obj *condition) Then() Instructions {
+	return obj.then
+}
+
+// HasElse returns true if there is an else branch, false otherwise
+func (obj *condition) HasElse() bool {
+	return obj.els != nil
+}
+
+// Else returns the else branch, if any
+func (obj *condition) Else() Instructions {
+	return obj.els
+}
@@ -0,0 +1,63 @@
+package programs
+
+import "errors"
+
+type conditionBuilder struct {
+	value Value
+	then  Instructions
+	els   Instructions
+}
+
+func createConditionBuilder() ConditionBuilder {
+	out := conditionBuilder{
+		value: nil,
+		then:  nil,
+		els:   nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *conditionBuilder) Create() ConditionBuilder {
+	return createConditionBuilder()
+}
+
+// WithValue adds a value to the builder
+func (app *conditionBuilder) WithValue(value Value) ConditionBuilder {
+	app.value = value
+	return app
+}
+
+// WithThen adds a then branch to the builder
+func (app *conditionBuilder) WithThen(then Instructions) ConditionBuilder {
+	app.then = then
+	return app
+}
+
+// WithElse adds an else branch to the builder
+func (app *conditionBuilder) WithElse(els Instructions) ConditionBuilder {
+	app.els = els
+	return app
+}
+
+// Now builds a new Condition instance
+func (app *conditionBuilder) Now() (Condition, error) {
+	if app.value == nil {
+		return nil, errors.New("the value is mandatory in order to build a Condition instance")
+	}
+
+	if app.then != nil && app.els != nil {
+		return createConditionWithThenAndElse(app.value, app.then, app.els), nil
+	}
+
+	if app.then != nil {
+		return createConditionWithThen(app.value, app.then), nil
+	}
+
+	if app.els != nil {
+		return createConditionWithElse(app.value, app.els), nil
+	}
+
+	return createCondition(app.value), nil
+}
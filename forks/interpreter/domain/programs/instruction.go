@@ -0,0 +1,140 @@
+package programs
+
+type instruction struct {
+	value     Value
+	execution Application
+	variable  []byte
+	condition Condition
+	loop      Loop
+	tryIns    Try
+	fail      Value
+}
+
+func createInstructionWithValue(
+	value Value,
+	variable []byte,
+) Instruction {
+	return createInstructionInternally(value, nil, variable, nil, nil, nil, nil)
+}
+
+func createInstructionWithExecution(
+	execution Application,
+) Instruction {
+	return createInstructionInternally(nil, execution, nil, nil, nil, nil, nil)
+}
+
+func createInstructionWithCondition(
+	condition Condition,
+) Instruction {
+	return createInstructionInternally(nil, nil, nil, condition, nil, nil, nil)
+}
+
+func createInstructionWithLoop(
+	loop Loop,
+) Instruction {
+	return createInstructionInternally(nil, nil, nil, nil, loop, nil, nil)
+}
+
+func createInstructionWithTry(
+	tryIns Try,
+) Instruction {
+	return createInstructionInternally(nil, nil, nil, nil, nil, tryIns, nil)
+}
+
+func createInstructionWithFail(
+	fail Value,
+) Instruction {
+	return createInstructionInternally(nil, nil, nil, nil, nil, nil, fail)
+}
+
+func createInstructionInternally(
+	value Value,
+	execution Application,
+	variable []byte,
+	condition Condition,
+	loop Loop,
+	tryIns Try,
+	fail Value,
+) Instruction {
+	out := instruction{
+		value:     value,
+		execution: execution,
+		variable:  variable,
+		condition: condition,
+		loop:      loop,
+		tryIns:    tryIns,
+		fail:      fail,
+	}
+
+	return &out
+}
+
+// IsValue returns true if there is a value, false otherwise
+func (obj *instruction) IsValue() bool {
+	return obj.value != nil
+}
+
+// Value returns the value, if any
+func (obj *instruction) Value() Value {
+	return obj.value
+}
+
+// IsExecution returns true if there is an execution, false otherwise
+func (obj *instruction) IsExecution() bool {
+	return obj.execution != nil
+}
+
+// Execution returns the execution, if any
+func (obj *instruction) Execution() Application {
+	return obj.execution
+}
+
+// HasVariable returns true if the value is assigned to a variable, false otherwise
+func (obj *instruction) HasVariable() bool {
+	return obj.variable != nil
+}
+
+// Variable returns the name of the variable the value is assigned to, if any
+func (obj *instruction) Variable() []byte {
+	return obj.variable
+}
+
+// IsCondition returns true if there is a condition, false otherwise
+func (obj *instruction) IsCondition() bool {
+	return obj.condition != nil
+}
+
+// Condition returns the condition, if any
+func (obj *instruction) Condition() Condition {
+	return obj.condition
+}
+
+// IsLoop returns true if there is a loop, false otherwise
+func (obj *instruction) IsLoop() bool {
+	return obj.loop != nil
+}
+
+// Loop returns the loop, if any
+func (obj *instruction) Loop() Loop {
+	return obj.loop
+}
+
+// IsTry returns true if there is a try, false otherwise
+func (obj *instruction) IsTry() bool {
+	return obj.tryIns != nil
+}
+
+// Try returns the try, if any
+func (obj *instruction) Try() Try {
+	return obj.tryIns
+}
+
+// IsFail returns true if there is a fail, false otherwise
+func (obj *instruction) IsFail() bool {
+	return obj.fail != nil
+}
+
+// Fail returns the value of the message of the fail, if any
+func (obj *instruction) Fail() Value {
+	return obj.fail
+}
@@ -0,0 +1,111 @@
+package programs
+
+import "errors"
+
+type instructionBuilder struct {
+	value     Value
+	execution Application
+	variable  []byte
+	condition Condition
+	loop      Loop
+	tryIns    Try
+	fail      Value
+}
+
+func createInstructionBuilder() InstructionBuilder {
+	out := instructionBuilder{
+		value:     nil,
+		execution: nil,
+		variable:  nil,
+		condition: nil,
+		loop:      nil,
+		tryIns:    nil,
+		fail:      nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *instructionBuilder) Create() InstructionBuilder {
+	return createInstructionBuilder()
+}
+
+// WithValue adds a value to the builder
+func (app *instructionBuilder) WithValue(value Value) InstructionBuilder {
+	app.value = value
+	return app
+}
+
+// WithExecution adds an execution to the builder
+func (app *instructionBuilder) WithExecution(execution Application) InstructionBuilder {
+	app.execution = execution
+	return app
+}
+
+// WithVariable adds the name of the variable the value is assigned to, to the builder
+func (app *instructionBuilder) WithVariable(variable []byte) InstructionBuilder {
+	app.variable = variable
+	return app
+}
+
+// WithCondition adds a condition to the builder
+func (app *instructionBuilder) WithCondition(condition Condition) InstructionBuilder {
+	app.condition = condition
+	return app
+}
+
+// WithLoop adds a loop to the builder
+func (app *instructionBuilder) WithLoop(loop Loop) InstructionBuilder {
+	app.loop = loop
+	return app
+}
+
+// WithTry adds a try to the builder
+func (app *instructionBuilder) WithTry(tryIns Try) InstructionBuilder {
+	app.tryIns = tryIns
+	return app
+}
+
+// WithFail adds the value of the message of a fail to the builder
+func (app *instructionBuilder) WithFail(fail Value) InstructionBuilder {
+	app.fail = fail
+	return app
+}
+
+// Now builds a new Instruction instance
+func (app *instructionBuilder) Now() (Instruction, error) {
+	if app.variable != nil && len(app.variable) <= 0 {
+		app.variable = nil
+	}
+
+	if app.value != nil {
+		return createInstructionWithValue(app.value, app.variable), nil
+	}
+
+	if app.variable != nil {
+		return nil, errors.New("the variable can only be added to an Instruction that contains a value")
+	}
+
+	if app.execution != nil {
+		return createInstructionWithExecution(app.execution), nil
+	}
+
+	if app.condition != nil {
+		return createInstructionWithCondition(app.condition), nil
+	}
+
+	if app.loop != nil {
+		return createInstructionWithLoop(app.loop), nil
+	}
+
+	if app.tryIns != nil {
+		return createInstructionWithTry(app.tryIns), nil
+	}
+
+	if app.fail != nil {
+		return createInstructionWithFail(app.fail), nil
+	}
+
+	return nil, errors.New("the Instruction is invalid")
+}
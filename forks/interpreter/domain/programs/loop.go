@@ -0,0 +1,72 @@
+package programs
+
+type loop struct {
+	value        Value
+	item         []byte
+	pMaximum     *uint
+	instructions Instructions
+	output       []byte
+}
+
+func createLoop(
+	value Value,
+	item []byte,
+	pMaximum *uint,
+	instructions Instructions,
+	output []byte,
+) Loop {
+	out := loop{
+		value:        value,
+		item:         item,
+		pMaximum:     pMaximum,
+		instructions: instructions,
+		output:       output,
+	}
+
+	return &out
+}
+
+// Value returns the value
+func (obj *loop) Value() Value {
+	return obj.value
+}
+
+// HasItem returns true if there is an item, false otherwise
+func (obj *loop) HasItem() bool {
+	return obj.item != nil
+}
+
+// Item returns the item, if any
+func (obj *loop) Item() []byte {
+	return obj.item
+}
+
+// HasMaximum returns true if there is a maximum, false otherwise
+func (obj *loop) HasMaximum() bool {
+	return obj.pMaximum != nil
+}
+
+// Maximum returns the maximum, if any
+func (obj *loop) Maximum() *uint {
+	return obj.pMaximum
+}
+
+// HasInstructions returns true if there is instructions, false otherwise
+func (obj *loop) HasInstructions() bool {
+	return obj.instructions != nil
+}
+
+// Instructions returns the instructions, if any
+func (obj *loop) Instructions() Instructions {
+	return obj.instructions
+}
+
+// HasOutput returns true if there is an output, false otherwise
+func (obj *loop) HasOutput() bool {
+	return obj.output != nil
+}
+
+// Output returns the name of the variable collected after every iteration, if any
+func (obj *loop) Output() []byte {
+	return obj.output
+}
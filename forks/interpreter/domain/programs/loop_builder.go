@@ -0,0 +1,83 @@
+package programs
+
+import "errors"
+
+type loopBuilder struct {
+	value        Value
+	item         []byte
+	pMaximum     *uint
+	instructions Instructions
+	output       []byte
+}
+
+func createLoopBuilder() LoopBuilder {
+	out := loopBuilder{
+		value:        nil,
+		item:         nil,
+		pMaximum:     nil,
+		instructions: nil,
+		output:       nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *loopBuilder) Create() LoopBuilder {
+	return createLoopBuilder()
+}
+
+// WithValue adds a value to the builder
+func (app *loopBuilder) WithValue(value Value) LoopBuilder {
+	app.value = value
+	return app
+}
+
+// WithItem adds an item to the builder
+func (app *loopBuilder) WithItem(item []byte) LoopBuilder {
+	app.item = item
+	return app
+}
+
+// WithMaximum adds a maximum to the builder
+func (app *loopBuilder) WithMaximum(maximum uint) LoopBuilder {
+	app.pMaximum = &maximum
+	return app
+}
+
+// WithInstructions add instructions to the builder
+func (app *loopBuilder) WithInstructions(instructions Instructions) LoopBuilder {
+	app.instructions = instructions
+	return app
+}
+
+// WithOutput adds an output to the builder
+func (app *loopBuilder) WithOutput(output []byte) LoopBuilder {
+	app.output = output
+	return app
+}
+
+// Now builds a new Loop instance
+func (app *loopBuilder) Now() (Loop, error) {
+	if app.value == nil {
+		return nil, errors.New("the value is mandatory in order to build a Loop instance")
+	}
+
+	if app.item != nil && len(app.item) <= 0 {
+		app.item = nil
+	}
+
+	if app.output != nil && len(app.output) <= 0 {
+		app.output = nil
+	}
+
+	if app.item != nil && app.pMaximum != nil {
+		return nil, errors.New("the Loop cannot contain both an item and a maximum")
+	}
+
+	if app.item == nil && app.pMaximum == nil {
+		return nil, errors.New("the Loop must contain either an item or a maximum")
+	}
+
+	return createLoop(app.value, app.item, app.pMaximum, app.instructions, app.output), nil
+}
@@ -0,0 +1,37 @@
+package modules
+
+import "context"
+
+type module struct {
+	index     uint
+	contextFn ExecuteContextFn
+}
+
+func createModule(
+	index uint,
+	contextFn ExecuteContextFn,
+) Module {
+	out := module{
+		index:     index,
+		contextFn: contextFn,
+	}
+
+	return &out
+}
+
+// Index returns the index
+func (obj *module) Index() uint {
+	return obj.index
+}
+
+// Func returns the execute fn, executed using a background context
+func (obj *module) Func() ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		return obj.contextFn(context.Background(), input)
+	}
+}
+
+// ContextFunc returns the execute fn that observes the context
+func (obj *module) ContextFunc() ExecuteContextFn {
+	return obj.contextFn
+}
@@ -0,0 +1,74 @@
+package modules
+
+import (
+	"context"
+	"errors"
+)
+
+type moduleBuilder struct {
+	pIndex    *uint
+	fn        ExecuteFn
+	contextFn ExecuteContextFn
+}
+
+func createModuleBuilder() ModuleBuilder {
+	out := moduleBuilder{
+		pIndex:    nil,
+		fn:        nil,
+		contextFn: nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *moduleBuilder) Create() ModuleBuilder {
+	return createModuleBuilder()
+}
+
+// WithIndex adds an index the builder
+func (app *moduleBuilder) WithIndex(index uint) ModuleBuilder {
+	app.pIndex = &index
+	return app
+}
+
+// WithFunc adds a func to the builder
+func (app *moduleBuilder) WithFunc(fn ExecuteFn) ModuleBuilder {
+	app.fn = fn
+	return app
+}
+
+// WithContextFunc adds a context func to the builder
+func (app *moduleBuilder) WithContextFunc(contextFn ExecuteContextFn) ModuleBuilder {
+	app.contextFn = contextFn
+	return app
+}
+
+// Now builds a new Module instance
+func (app *moduleBuilder) Now() (Module, error) {
+	if app.pIndex == nil {
+		return nil, errors.New("the index is mandatory in order to build a Module instance")
+	}
+
+	if app.fn != nil && app.contextFn != nil {
+		return nil, errors.New("the execute func and the execute context func cannot both be set in order to build a Module instance")
+	}
+
+	if app.contextFn != nil {
+		return createModule(*app.pIndex, app.contextFn), nil
+	}
+
+	if app.fn != nil {
+		fn := app.fn
+		return createModule(*app.pIndex, func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
+			err := ctx.Err()
+			if err != nil {
+				return nil, err
+			}
+
+			return fn(input)
+		}), nil
+	}
+
+	return nil, errors.New("the execute func is mandatory in order to build a Module instance")
+}
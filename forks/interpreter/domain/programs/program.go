@@ -0,0 +1,74 @@
+package programs
+
+type program struct {
+	instructions Instructions
+	inputs       [][]byte
+	outputs      [][]byte
+}
+
+func createProgram(
+	instructions Instructions,
+) Program {
+	return createProgramInternally(instructions, nil, nil)
+}
+
+func createProgramWithInputs(
+	instructions Instructions,
+	inputs [][]byte,
+) Program {
+	return createProgramInternally(instructions, inputs, nil)
+}
+
+func createProgramWithOutputs(
+	instructions Instructions,
+	outputs [][]byte,
+) Program {
+	return createProgramInternally(instructions, nil, outputs)
+}
+
+func createProgramWithInputsAndOutputs(
+	instructions Instructions,
+	inputs [][]byte,
+	outputs [][]byte,
+) Program {
+	return createProgramInternally(instructions, inputs, outputs)
+}
+
+func createProgramInternally(
+	instructions Instructions,
+	inputs [][]byte,
+	outputs [][]byte,
+) Program {
+	out := program{
+		instructions: instructions,
+		inputs:       inputs,
+		outputs:      outputs,
+	}
+
+	return &out
+}
+
+// Instructions returns the instructions
+func (obj *program) Instructions() Instructions {
+	return obj.instructions
+}
+
+// HasInputs returns true if there is inputs, false otherwise
+func (obj *program) HasInputs() bool {
+	return obj.inputs != nil
+}
+
+// Inputs returns the names of the input variables, ordered by their index, if any
+func (obj *program) Inputs() [][]byte {
+	return obj.inputs
+}
+
+// HasOutputs returns true if there is outputs, false otherwise
+func (obj *program) HasOutputs() bool {
+	return obj.outputs != nil
+}
+
+// Outputs returns the names of the output variables, if any
+func (obj *program) Outputs() [][]byte {
+	return obj.outputs
+}
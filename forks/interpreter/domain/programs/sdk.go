@@ -0,0 +1,261 @@
+package programs
+
+import (
+	"github.com/steve-care-software/interpreter/domain/programs/modules"
+)
+
+// NewBuilder creates a new builder instance
+func NewBuilder() Builder {
+	return createBuilder()
+}
+
+// NewInstructionsBuilder creates a new instructions builder
+func NewInstructionsBuilder() InstructionsBuilder {
+	return createInstructionsBuilder()
+}
+
+// NewInstructionBuilder creates a new instruction builder
+func NewInstructionBuilder() InstructionBuilder {
+	return createInstructionBuilder()
+}
+
+// NewApplicationBuilder creates a new application builder instance
+func NewApplicationBuilder() ApplicationBuilder {
+	return createApplicationBuilder()
+}
+
+// NewAttachmentsBuilder creates a new attachments builder
+func NewAttachmentsBuilder() AttachmentsBuilder {
+	return createAttachmentsBuilder()
+}
+
+// NewAttachmentBuilder creates a new attachment builder
+func NewAttachmentBuilder() AttachmentBuilder {
+	return createAttachmentBuilder()
+}
+
+// NewConditionBuilder creates a new condition builder
+func NewConditionBuilder() ConditionBuilder {
+	return createConditionBuilder()
+}
+
+// NewTryBuilder creates a new try builder
+func NewTryBuilder() TryBuilder {
+	return createTryBuilder()
+}
+
+// NewLoopBuilder creates a new loop builder
+func NewLoopBuilder() LoopBuilder {
+	return createLoopBuilder()
+}
+
+// NewValueBuilder creates a new value builder
+func NewValueBuilder() ValueBuilder {
+	return createValueBuilder()
+}
+
+// Builder represents a program builder
+type Builder interface {
+	Create() Builder
+	WithInstructions(instructions Instructions) Builder
+	WithInputs(inputs [][]byte) Builder
+	WithOutputs(outputs [][]byte) Builder
+	Now() (Program, error)
+}
+
+// Program represents a program
+type Program interface {
+	Instructions() Instructions
+	HasInputs() bool
+	Inputs() [][]byte
+	HasOutputs() bool
+	Outputs() [][]byte
+}
+
+// InstructionsBuilder represents instructions builder
+type InstructionsBuilder interface {
+	Create() InstructionsBuilder
+	WithList(list []Instruction) InstructionsBuilder
+	Now() (Instructions, error)
+}
+
+// Instructions represents instructions
+type Instructions interface {
+	List() []Instruction
+}
+
+// InstructionBuilder represents an instruction builder
+type InstructionBuilder interface {
+	Create() InstructionBuilder
+	WithValue(value Value) InstructionBuilder
+	WithExecution(execution Application) InstructionBuilder
+	WithVariable(variable []byte) InstructionBuilder
+	WithCondition(condition Condition) InstructionBuilder
+	WithLoop(loop Loop) InstructionBuilder
+	WithTry(tryIns Try) InstructionBuilder
+	WithFail(fail Value) InstructionBuilder
+	Now() (Instruction, error)
+}
+
+// Instruction represents an instruction
+type Instruction interface {
+	IsValue() bool
+	Value() Value
+	IsExecution() bool
+	Execution() Application
+	HasVariable() bool
+	Variable() []byte
+	IsCondition() bool
+	Condition() Condition
+	IsLoop() bool
+	Loop() Loop
+	IsTry() bool
+	Try() Try
+	IsFail() bool
+	Fail() Value
+}
+
+// ConditionBuilder represents a condition builder
+type ConditionBuilder interface {
+	Create() ConditionBuilder
+	WithValue(value Value) ConditionBuilder
+	WithThen(then Instructions) ConditionBuilder
+	WithElse(els Instructions) ConditionBuilder
+	Now() (Condition, error)
+}
+
+// Condition represents a condition executing its then branch when its value is true, its else branch otherwise
+type Condition interface {
+	Value() Value
+	HasThen() bool
+	Then() Instructions
+	HasElse() bool
+	Else() Instructions
+}
+
+// LoopBuilder represents a loop builder
+type LoopBuilder interface {
+	Create() LoopBuilder
+	WithValue(value Value) LoopBuilder
+	WithItem(item []byte) LoopBuilder
+	WithMaximum(maximum uint) LoopBuilder
+	WithInstructions(instructions Instructions) LoopBuilder
+	WithOutput(output []byte) LoopBuilder
+	Now() (Loop, error)
+}
+
+// Loop represents a loop, executing its instructions in a new scope for each item of the list contained in its value,
+// or while its value is true without exceeding its maximum amount of iterations
+type Loop interface {
+	Value() Value
+	HasItem() bool
+	Item() []byte
+	HasMaximum() bool
+	Maximum() *uint
+	HasInstructions() bool
+	Instructions() Instructions
+	HasOutput() bool
+	Output() []byte
+}
+
+// TryBuilder represents a try builder
+type TryBuilder interface {
+	Create() TryBuilder
+	WithInstructions(instructions Instructions) TryBuilder
+	WithVariable(variable []byte) TryBuilder
+	WithCatch(catch Instructions) TryBuilder
+	Now() (Try, error)
+}
+
+// Try represents a try, executing its catch branch in a new scope with the error assigned to its variable when its instructions fail
+type Try interface {
+	HasInstructions() bool
+	Instructions() Instructions
+	Variable() []byte
+	HasCatch() bool
+	Catch() Instructions
+}
+
+// ApplicationBuilder represents an application builder
+type ApplicationBuilder interface {
+	Create() ApplicationBuilder
+	WithIndex(index uint) ApplicationBuilder
+	WithModule(module modules.Module) ApplicationBuilder
+	WithCallable(callable []byte) ApplicationBuilder
+	WithAttachments(attachments Attachments) ApplicationBuilder
+	WithName(name []byte) ApplicationBuilder
+	WithModuleName(moduleName []byte) ApplicationBuilder
+	Now() (Application, error)
+}
+
+// Application represents an application, executing either a module or the callable assigned to a variable
+type Application interface {
+	Index() uint
+	IsModule() bool
+	Module() modules.Module
+	IsCallable() bool
+	Callable() []byte
+	HasAttachments() bool
+	Attachments() Attachments
+	HasName() bool
+	Name() []byte
+	HasModuleName() bool
+	ModuleName() []byte
+}
+
+// AttachmentsBuilder represents the attachments builder
+type AttachmentsBuilder interface {
+	Create() AttachmentsBuilder
+	WithList(list []Attachment) AttachmentsBuilder
+	Now() (Attachments, error)
+}
+
+// Attachments represents attachments
+type Attachments interface {
+	List() []Attachment
+}
+
+// AttachmentBuilder represents an attachment builder
+type AttachmentBuilder interface {
+	Create() AttachmentBuilder
+	WithValue(value Value) AttachmentBuilder
+	WithLocal(local uint) AttachmentBuilder
+	Now() (Attachment, error)
+}
+
+// Attachment represents an attachment
+type Attachment interface {
+	Value() Value
+	Local() uint
+}
+
+// ValueBuilder represents a value builder
+type ValueBuilder interface {
+	Create() ValueBuilder
+	WithInput(input uint) ValueBuilder
+	WithConstant(constant []byte) ValueBuilder
+	WithLiteral(literal interface{}) ValueBuilder
+	WithExecution(execution Application) ValueBuilder
+	WithProgram(program Program) ValueBuilder
+	WithVariable(variable []byte) ValueBuilder
+	WithLoop(loop Loop) ValueBuilder
+	Now() (Value, error)
+}
+
+// Value represents a value
+type Value interface {
+	IsInput() bool
+	Input() *uint
+	IsConstant() bool
+	Constant() []byte
+	IsLiteral() bool
+	Literal() interface{}
+	IsExecution() bool
+	Execution() Application
+	IsProgram() bool
+	Program() Program
+	IsVariable() bool
+	Variable() []byte
+	IsLoop() bool
+	Loop() Loop
+}
@@ -0,0 +1,67 @@
+package programs
+
+import "errors"
+
+type tryBuilder struct {
+	instructions Instructions
+	variable     []byte
+	catch        Instructions
+}
+
+func createTryBuilder() TryBuilder {
+	out := tryBuilder{
+		instructions: nil,
+		variable:     nil,
+		catch:        nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *tryBuilder) Create() TryBuilder {
+	return createTryBuilder()
+}
+
+// WithInstructions add instructions to the builder
+func (app *tryBuilder) WithInstructions(instructions Instructions) TryBuilder {
+	app.instructions = instructions
+	return app
+}
+
+// WithVariable adds a variable to the builder
+func (app *tryBuilder) WithVariable(variable []byte) TryBuilder {
+	app.variable = variable
+	return app
+}
+
+// WithCatch adds a catch branch to the builder
+func (app *tryBuilder) WithCatch(catch Instructions) TryBuilder {
+	app.catch = catch
+	return app
+}
+
+// Now builds a new Try instance
+func (app *tryBuilder) Now() (Try, error) {
+	if app.variable != nil && len(app.variable) <= 0 {
+		app.variable = nil
+	}
+
+	if app.variable == nil {
+		return nil, errors.New("the variable is mandatory in order to build a Try instance")
+	}
+
+	if app.instructions != nil && app.catch != nil {
+		return createTryWithInstructionsAndCatch(app.instructions, app.variable, app.catch), nil
+	}
+
+	if app.instructions != nil {
+		return createTryWithInstructions(app.instructions, app.variable), nil
+	}
+
+	if app.catch != nil {
+		return createTryWithCatch(app.variable, app.catch), nil
+	}
+
+	return createTry(app.variable), nil
+}
@@ -0,0 +1,145 @@
+package programs
+
+type value struct {
+	pInput    *uint
+	constant  []byte
+	literal   interface{}
+	execution Application
+	program   Program
+	variable  []byte
+	loop      Loop
+}
+
+func createValueWithInput(
+	pInput *uint,
+) Value {
+	return createValueInternally(pInput, nil, nil, nil, nil, nil, nil)
+}
+
+func createValueWithConstant(
+	constant []byte,
+) Value {
+	return createValueInternally(nil, constant, nil, nil, nil, nil, nil)
+}
+
+func createValueWithLiteral(
+	literal interface{},
+) Value {
+	return createValueInternally(nil, nil, literal, nil, nil, nil, nil)
+}
+
+func createValueWithExecution(
+	execution Application,
+) Value {
+	return createValueInternally(nil, nil, nil, execution, nil, nil, nil)
+}
+
+func createValueWithProgram(
+	program Program,
+) Value {
+	return createValueInternally(nil, nil, nil, nil, program, nil, nil)
+}
+
+func createValueWithVariable(
+	variable []byte,
+) Value {
+	return createValueInternally(nil, nil, nil, nil, nil, variable, nil)
+}
+
+func createValueWithLoop(
+	loop Loop,
+) Value {
+	return createValueInternally(nil, nil, nil, nil, nil, nil, loop)
+}
+
+func createValueInternally(
+	pInput *uint,
+	constant []byte,
+	literal interface{},
+	execution Application,
+	program Program,
+	variable []byte,
+	loop Loop,
+) Value {
+	out := value{
+		pInput:    pInput,
+		constant:  constant,
+		literal:   literal,
+		execution: execution,
+		program:   program,
+		variable:  variable,
+		loop:      loop,
+	}
+
+	return &out
+}
+
+// IsInput returns true if input, false otherwise
+func (obj *value) IsInput() bool {
+	return obj.pInput != nil
+}
+
+// Input returns the input, if any
+func (obj *value) Input() *uint {
+	return obj.pInput
+}
+
+// IsConstant returns true if []byte, false otherwise
+func (obj *value) IsConstant() bool {
+	return obj.constant != nil
+}
+
+// Constant returns the []byte, if any
+func (obj *value) Constant() []byte {
+	return obj.constant
+}
+
+// IsLiteral returns true if literal, false otherwise
+func (obj *value) IsLiteral() bool {
+	return obj.literal != nil
+}
+
+// Literal returns the typed value of the literal, if any
+func (obj *value) Literal() interface{} {
+	return obj.literal
+}
+
+// IsExecution returns true if execution, false otherwise
+func (obj *value) IsExecution() bool {
+	return obj.execution != nil
+}
+
+// Execution returns the execution, if any
+func (obj *value) Execution() Application {
+	return obj.execution
+}
+
+// IsProgram returns true if program, false otherwise
+func (obj *value) IsProgram() bool {
+	return obj.program != nil
+}
+
+// Program returns the program, if any
+func (obj *value) Program() Program {
+	return obj.program
+}
+
+// IsVariable returns true if variable, false otherwise
+func (obj *value) IsVariable() bool {
+	return obj.variable != nil
+}
+
+// Variable returns the name of the variable whose executed value is referenced, if any
+func (obj *value) Variable() []byte {
+	return obj.variable
+}
+
+// IsLoop returns true if there is a loop, false otherwise
+func (obj *value) IsLoop() bool {
+	return obj.loop != nil
+}
+
+// Loop returns the loop, if any
+func (obj *value) Loop() Loop {
+	return obj.loop
+}
@@ -0,0 +1,109 @@
+package programs
+
+import (
+	"errors"
+)
+
+type valueBuilder struct {
+	pInput    *uint
+	constant  []byte
+	literal   interface{}
+	execution Application
+	program   Program
+	variable  []byte
+	loop      Loop
+}
+
+func createValueBuilder() ValueBuilder {
+	out := valueBuilder{
+		pInput:    nil,
+		constant:  nil,
+		literal:   nil,
+		execution: nil,
+		program:   nil,
+		variable:  nil,
+		loop:      nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *valueBuilder) Create() ValueBuilder {
+	return createValueBuilder()
+}
+
+// WithInput adds an input to the builder
+func (app *valueBuilder) WithInput(input uint) ValueBuilder {
+	app.pInput = &input
+	return app
+}
+
+// WithConstant adds a constant to the builder
+func (app *valueBuilder) WithConstant(constant []byte) ValueBuilder {
+	app.constant = constant
+	return app
+}
+
+// WithLiteral adds a literal to the builder
+func (app *valueBuilder) WithLiteral(literal interface{}) ValueBuilder {
+	app.literal = literal
+	return app
+}
+
+// WithExecution adds an execution to the builder
+func (app *valueBuilder) WithExecution(execution Application) ValueBuilder {
+	app.execution = execution
+	return app
+}
+
+// WithProgram adds a program to the builder
+func (app *valueBuilder) WithProgram(program Program) ValueBuilder {
+	app.program = program
+	return app
+}
+
+// WithVariable adds a variable to the builder
+func (app *valueBuilder) WithVariable(variable []byte) ValueBuilder {
+	app.variable = variable
+	return app
+}
+
+// WithLoop adds a loop to the builder
+func (app *valueBuilder) WithLoop(loop Loop) ValueBuilder {
+	app.loop = loop
+	return app
+}
+
+// Now builds a new Value instance
+func (app *valueBuilder) Now() (Value, error) {
+	if app.pInput != nil {
+		return createValueWithInput(app.pInput), nil
+	}
+
+	if app.constant != nil {
+		return createValueWithConstant(app.constant), nil
+	}
+
+	if app.literal != nil {
+		return createValueWithLiteral(app.literal), nil
+	}
+
+	if app.execution != nil {
+		return createValueWithExecution(app.execution), nil
+	}
+
+	if app.program != nil {
+		return createValueWithProgram(app.program), nil
+	}
+
+	if app.variable != nil && len(app.variable) > 0 {
+		return createValueWithVariable(app.variable), nil
+	}
+
+	if app.loop != nil {
+		return createValueWithLoop(app.loop), nil
+	}
+
+	return nil, errors.New("the Value is invalid")
+}
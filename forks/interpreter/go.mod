@@ -0,0 +1,3 @@
+module github.com/steve-care-software/interpreter
+
+go 1.16
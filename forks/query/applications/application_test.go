@@ -0,0 +1,37 @@
+package queries
+
+import (
+	"testing"
+
+	"github.com/steve-care-software/ast/domain/trees"
+	"github.com/steve-care-software/query/domain/queries"
+)
+
+func TestApplication_insideInstances_absentElement_Success(t *testing.T) {
+	contentFn, err := queries.NewContentFnBuilder().Create().WithSingle(func(content trees.Content) ([]interface{}, error) {
+		return []interface{}{content}, nil
+	}).Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	inside, err := queries.NewInsideBuilder().Create().WithFn(contentFn).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	app := createApplication().(*application)
+	list, err := app.insideInstances(inside, []trees.Content{}, nil)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(list) != 0 {
+		t.Errorf("the element absent from the tree was expected to have no instance, %d returned", len(list))
+		return
+	}
+}
@@ -0,0 +1,7 @@
+module github.com/steve-care-software/query
+
+go 1.16
+
+require github.com/steve-care-software/ast v0.0.0-20230108063820-4c69a75302be
+
+replace github.com/steve-care-software/ast => ../ast
@@ -0,0 +1,97 @@
+package applications
+
+import (
+	"errors"
+
+	ast_applications "github.com/steve-care-software/ast/applications"
+	"github.com/steve-care-software/ast/domain/grammars"
+	interpreter_applications "github.com/steve-care-software/interpreter/applications"
+	query_applications "github.com/steve-care-software/query/applications"
+	"github.com/steve-care-software/query/domain/queries"
+)
+
+type builder struct {
+	astApplication      ast_applications.Application
+	queryApplication    query_applications.Application
+	nameBytesToStringFn interpreter_applications.NameBytesToString
+	grammar             grammars.Grammar
+	query               queries.Query
+	fetchModulesFn      FetchModulesFn
+	importFn            interpreter_applications.ImportFn
+}
+
+func createBuilder(
+	astApplication ast_applications.Application,
+	queryApplication query_applications.Application,
+	nameBytesToStringFn interpreter_applications.NameBytesToString,
+) Builder {
+	out := builder{
+		astApplication:      astApplication,
+		queryApplication:    queryApplication,
+		nameBytesToStringFn: nameBytesToStringFn,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *builder) Create() Builder {
+	return createBuilder(
+		app.astApplication,
+		app.queryApplication,
+		app.nameBytesToStringFn,
+	)
+}
+
+// WithGrammar adds a grammar to the builder
+func (app *builder) WithGrammar(grammar grammars.Grammar) Builder {
+	app.grammar = grammar
+	return app
+}
+
+// WithQuery adds a query to the builder
+func (app *builder) WithQuery(query queries.Query) Builder {
+	app.query = query
+	return app
+}
+
+// WithFetchModulesFn adds a fetchModulesFn to the builder
+func (app *builder) WithFetchModulesFn(fetchModulesFn FetchModulesFn) Builder {
+	app.fetchModulesFn = fetchModulesFn
+	return app
+}
+
+// WithImportFn adds an importFn to the builder
+func (app *builder) WithImportFn(importFn interpreter_applications.ImportFn) Builder {
+	app.importFn = importFn
+	return app
+}
+
+// Now builds a new Application instance
+func (app *builder) Now() (Application, error) {
+	if app.grammar == nil {
+		return nil, errors.New("the grammar is mandatory in order to build an Application instance")
+	}
+
+	if app.query == nil {
+		return nil, errors.New("the query is mandatory in order to build an Application instance")
+	}
+
+	if app.fetchModulesFn == nil {
+		return nil, errors.New("the fetchModulesFn is mandatory in order to build an Application instance")
+	}
+
+	interpreterApplication := interpreter_applications.NewApplicationWithImportFn(
+		app.nameBytesToStringFn,
+		app.importFn,
+	)
+
+	return createApplication(
+		app.astApplication,
+		app.queryApplication,
+		interpreterApplication,
+		app.grammar,
+		app.query,
+		app.fetchModulesFn,
+	), nil
+}
@@ -0,0 +1,15 @@
+module github.com/steve-care-software/vm
+
+go 1.16
+
+require (
+	github.com/steve-care-software/ast v0.0.0-20230108063820-4c69a75302be
+	github.com/steve-care-software/interpreter v0.0.0-20230108070439-840cfffadfca
+	github.com/steve-care-software/query v0.0.0-20230108070738-7585b8097427
+)
+
+replace (
+	github.com/steve-care-software/ast => ../ast
+	github.com/steve-care-software/interpreter => ../interpreter
+	github.com/steve-care-software/query => ../query
+)
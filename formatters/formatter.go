@@ -0,0 +1,109 @@
+package formatters
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	ast_applications "github.com/steve-care-software/ast/applications"
+	"github.com/steve-care-software/ast/domain/grammars"
+	"github.com/steve-care-software/ast/domain/trees"
+	query_applications "github.com/steve-care-software/query/applications"
+	"github.com/steve-care-software/query/domain/queries"
+)
+
+type formatter struct {
+	astApplication   ast_applications.Application
+	queryApplication query_applications.Application
+	grammar          grammars.Grammar
+	query            queries.Query
+}
+
+func createFormatter(
+	astApplication ast_applications.Application,
+	queryApplication query_applications.Application,
+	grammar grammars.Grammar,
+	query queries.Query,
+) Formatter {
+	out := formatter{
+		astApplication:   astApplication,
+		queryApplication: queryApplication,
+		grammar:          grammar,
+		query:            query,
+	}
+
+	return &out
+}
+
+// Format parses the script and returns it in its canonical form
+func (app *formatter) Format(script []byte) ([]byte, error) {
+	tree, err := app.parse(script)
+	if err != nil {
+		return nil, err
+	}
+
+	walker := createWalker()
+	walker.tree(tree, false)
+
+	printer := createPrinter()
+	for _, oneAtom := range walker.atoms {
+		printer.atom(oneAtom)
+	}
+
+	output := printer.end(walker.pending)
+
+	// the formatter only moves the channels, so the script must lex to the same content once formatted:
+	formatted, err := app.lex(output)
+	if err != nil {
+		str := fmt.Sprintf("the formatted script could not be lexed: %s", err.Error())
+		return nil, errors.New(str)
+	}
+
+	if !bytes.Equal(tree.Bytes(false), formatted.Bytes(false)) {
+		return nil, errors.New("the formatted script does not contain the same instructions as the script")
+	}
+
+	return output, nil
+}
+
+// IsFormatted returns true if the script is already in its canonical form, false otherwise
+func (app *formatter) IsFormatted(script []byte) (bool, error) {
+	output, err := app.Format(script)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(script, output), nil
+}
+
+func (app *formatter) parse(script []byte) (trees.Tree, error) {
+	tree, err := app.lex(script)
+	if err != nil {
+		return nil, err
+	}
+
+	_, isValid, _, err := app.queryApplication.Execute(app.query, tree)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isValid {
+		return nil, errors.New("the script could not be parsed into instructions")
+	}
+
+	return tree, nil
+}
+
+func (app *formatter) lex(script []byte) (trees.Tree, error) {
+	tree, err := app.astApplication.Execute(app.grammar, script)
+	if err != nil {
+		return nil, err
+	}
+
+	if tree.HasRemaining() {
+		str := fmt.Sprintf("the script contains data that could not be lexed: %s", tree.Remaining())
+		return nil, errors.New(str)
+	}
+
+	return tree, nil
+}
@@ -0,0 +1,128 @@
+package formatters
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestFormatter_Success(t *testing.T) {
+	script := `
+// the header comment
+module   @list:0;;
+	module @castToBool:11;;
+
+
+
+	-> $first;;
+  -> $second;;
+<- $output;;
+$isValid   =   @castToBool( $first );; // the trailing comment
+if $isValid {
+		// the comment inside the branch
+	$output = @list( $first,$second );;
+} else {
+$output = @list(
+	// the comment inside the call
+	$second, _ );;
+} ;;
+$empty = for $item in $first {};;
+$text = "a  b // not a comment";;
+$values = [ 1, -2.5, true, 0xff ];;
+$constant = some value;;
+// the footer comment
+`
+
+	expected := `// the header comment
+module @list:0;;
+module @castToBool:11;;
+
+-> $first;;
+-> $second;;
+<- $output;;
+$isValid = @castToBool($first);; // the trailing comment
+if $isValid {
+	// the comment inside the branch
+	$output = @list($first, $second);;
+} else {
+	$output = @list(
+		// the comment inside the call
+		$second, _);;
+};;
+$empty = for $item in $first {};;
+$text = "a  b // not a comment";;
+$values = [1, -2.5, true, 0xff];;
+$constant = some value;;
+// the footer comment
+`
+
+	formatter := NewFormatter()
+	output, err := formatter.Format([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if string(output) != expected {
+		t.Errorf("the formatted script was expected to be:\n%s\nreturned:\n%s", expected, output)
+		return
+	}
+
+	isFormatted, err := formatter.IsFormatted(output)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !isFormatted {
+		t.Errorf("the formatter was expected to be idempotent")
+		return
+	}
+
+	isFormatted, err = formatter.IsFormatted([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if isFormatted {
+		t.Errorf("the script was expected to not be formatted")
+		return
+	}
+}
+
+func TestFormatter_withScripts_areFormatted(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("..", "scripts", "*", "*", "*.rodan"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	formatter := NewFormatter()
+	for _, onePath := range paths {
+		script, err := ioutil.ReadFile(onePath)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		isFormatted, err := formatter.IsFormatted(script)
+		if err != nil {
+			t.Errorf("%s: the error was expected to be nil, error returned: %s", onePath, err.Error())
+			continue
+		}
+
+		if !isFormatted {
+			t.Errorf("the script (path: %s) was expected to be formatted", onePath)
+			continue
+		}
+	}
+}
+
+func TestFormatter_withInvalidScript_returnsError(t *testing.T) {
+	_, err := NewFormatter().Format([]byte("<- $output;; ~~~"))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
@@ -0,0 +1,207 @@
+package formatters
+
+import (
+	"bytes"
+	"strings"
+)
+
+// comment represents a single line comment found in the channels, with the amount of new lines found before it
+type comment struct {
+	text     []byte
+	newLines uint
+}
+
+// printer lays out the words of a script, one instruction per line
+type printer struct {
+	output           []byte
+	line             []byte
+	lineIndent       uint
+	indent           uint
+	previous         string
+	isContinuation   bool
+	isEnded          bool
+	isOpened         bool
+	isStatementStart bool
+	isBlockStart     bool
+}
+
+func createPrinter() *printer {
+	out := printer{
+		output:           []byte{},
+		line:             []byte{},
+		lineIndent:       0,
+		indent:           0,
+		previous:         "",
+		isContinuation:   false,
+		isEnded:          false,
+		isOpened:         false,
+		isStatementStart: true,
+		isBlockStart:     true,
+	}
+
+	return &out
+}
+
+func (app *printer) atom(atom atom) {
+	comments, newLines := parseChannels(atom.channels)
+	app.comments(comments)
+
+	content := string(atom.content)
+	if content == blockSuffix && app.isOpened && len(comments) <= 0 {
+		// an empty block stays on a single line:
+		app.write(content, false)
+		app.isOpened = false
+		return
+	}
+
+	if app.isEnded || app.isOpened {
+		app.breakLine()
+	}
+
+	if content == blockSuffix {
+		if app.indent > 0 {
+			app.indent--
+		}
+
+		app.write(content, false)
+		app.isStatementStart = false
+		app.isBlockStart = false
+		app.isContinuation = false
+		return
+	}
+
+	if app.isStatementStart && !app.isBlockStart && newLines >= 2 {
+		app.blank()
+	}
+
+	isSpaced := !noSpaceBefore[content] && !noSpaceAfter[app.previous] && atom.name != constantTokenName
+	app.write(content, isSpaced)
+	app.isStatementStart = false
+	app.isBlockStart = false
+	if content == blockPrefix {
+		app.isOpened = true
+	}
+
+	if atom.name == instructionTerminatorTokenName {
+		app.isEnded = true
+	}
+}
+
+func (app *printer) end(channels []byte) []byte {
+	comments, _ := parseChannels(channels)
+	app.comments(comments)
+	app.flush()
+	return app.output
+}
+
+func (app *printer) comments(comments []comment) {
+	for _, oneComment := range comments {
+		// a comment found on the same line as a word stays on its line:
+		if oneComment.newLines <= 0 && len(app.line) > 0 {
+			app.line = append(app.line, []byte(" ")...)
+			app.line = append(app.line, oneComment.text...)
+			app.breakLine()
+			continue
+		}
+
+		if len(app.line) > 0 {
+			app.breakLine()
+		}
+
+		if app.isStatementStart && !app.isBlockStart && oneComment.newLines >= 2 {
+			app.blank()
+		}
+
+		app.write(string(oneComment.text), false)
+		app.flush()
+		app.isBlockStart = false
+	}
+}
+
+// breakLine ends the current line, opening the block or the next statement if pending
+func (app *printer) breakLine() {
+	app.flush()
+	if app.isEnded {
+		app.isEnded = false
+		app.isStatementStart = true
+		app.isContinuation = false
+		return
+	}
+
+	if app.isOpened {
+		app.isOpened = false
+		app.indent++
+		app.isStatementStart = true
+		app.isBlockStart = true
+		app.isContinuation = false
+		return
+	}
+
+	// a statement broken by a comment continues on the next line, indented once more:
+	app.isContinuation = true
+}
+
+func (app *printer) write(content string, isSpaced bool) {
+	if len(app.line) <= 0 {
+		app.lineIndent = app.indent
+		if app.isContinuation {
+			app.lineIndent++
+		}
+	} else if isSpaced {
+		app.line = append(app.line, []byte(" ")...)
+	}
+
+	app.line = append(app.line, []byte(content)...)
+	app.previous = content
+}
+
+func (app *printer) flush() {
+	if len(app.line) <= 0 {
+		return
+	}
+
+	app.output = append(app.output, []byte(strings.Repeat(indentation, int(app.lineIndent)))...)
+	app.output = append(app.output, app.line...)
+	app.output = append(app.output, []byte("\n")...)
+	app.line = []byte{}
+	app.previous = ""
+}
+
+func (app *printer) blank() {
+	if len(app.output) <= 0 || bytes.HasSuffix(app.output, []byte("\n\n")) {
+		return
+	}
+
+	app.output = append(app.output, []byte("\n")...)
+}
+
+// parseChannels returns the comments found in the channels, and the amount of new lines found after the last one
+func parseChannels(channels []byte) ([]comment, uint) {
+	comments := []comment{}
+	newLines := uint(0)
+	for idx := 0; idx < len(channels); idx++ {
+		if channels[idx] == '\n' {
+			newLines++
+			continue
+		}
+
+		if !bytes.HasPrefix(channels[idx:], []byte(commentPrefix)) {
+			continue
+		}
+
+		end := idx
+		for end < len(channels) && channels[end] != '\n' && channels[end] != '\r' {
+			end++
+		}
+
+		comments = append(comments, comment{
+			text:     bytes.TrimRight(channels[idx:end], " \t"),
+			newLines: newLines,
+		})
+
+		newLines = 0
+		idx = end - 1
+	}
+
+	return comments, newLines
+}
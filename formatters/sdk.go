@@ -0,0 +1,83 @@
+package formatters
+
+import (
+	ast_applications "github.com/steve-care-software/ast/applications"
+	query_applications "github.com/steve-care-software/query/applications"
+	rodan_grammars "github.com/steve-care-software/rodan/grammars"
+	"github.com/steve-care-software/rodan/queries"
+)
+
+const indentation = "\t"
+const commentPrefix = "//"
+const blockPrefix = "{"
+const blockSuffix = "}"
+const instructionTerminator = ";;"
+const instructionTerminatorTokenName = "instructionTerminator"
+const constantTokenName = "endOfInstruction"
+
+// leaves contains the names of the tokens written as a single word
+var leaves = map[string]bool{
+	instructionTerminatorTokenName: true,
+	constantTokenName:              true,
+	"variableReference":            true,
+	"moduleReference":              true,
+	"moduleIndex":                  true,
+	"attachmentTarget":             true,
+	"loopMaximum":                  true,
+	"stringLiteral":                true,
+	"bytesLiteral":                 true,
+	"numberLiteral":                true,
+	"trueLiteral":                  true,
+	"falseLiteral":                 true,
+	"emptyListLiteral":             true,
+	"importPath":                   true,
+	"inputParameterPrefix":         true,
+	"outputParameterPrefix":        true,
+	"skippedCallArgument":          true,
+	"moduleKeyword":                true,
+	"attachKeyword":                true,
+	"executeKeyword":               true,
+	"ifKeyword":                    true,
+	"elseKeyword":                  true,
+	"forKeyword":                   true,
+	"inKeyword":                    true,
+	"whileKeyword":                 true,
+	"importKeyword":                true,
+	"asKeyword":                    true,
+	"tryKeyword":                   true,
+	"catchKeyword":                 true,
+	"failKeyword":                  true,
+}
+
+// noSpaceBefore contains the words never preceded by a space
+var noSpaceBefore = map[string]bool{
+	"(":                   true,
+	")":                   true,
+	",":                   true,
+	":":                   true,
+	"]":                   true,
+	instructionTerminator: true,
+}
+
+// noSpaceAfter contains the words never followed by a space
+var noSpaceAfter = map[string]bool{
+	"(": true,
+	":": true,
+	"[": true,
+}
+
+// NewFormatter creates a new formatter instance
+func NewFormatter() Formatter {
+	return createFormatter(
+		ast_applications.NewApplication(),
+		query_applications.NewApplication(),
+		rodan_grammars.NewInstructionsGrammar(),
+		queries.NewQuery(),
+	)
+}
+
+// Formatter represents a formatter re-emitting scripts in their canonical form
+type Formatter interface {
+	Format(script []byte) ([]byte, error)
+	IsFormatted(script []byte) (bool, error)
+}
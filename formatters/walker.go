@@ -0,0 +1,89 @@
+package formatters
+
+import (
+	"github.com/steve-care-software/ast/domain/trees"
+)
+
+// atom represents a word of the script, with the channels found before it
+type atom struct {
+	name     string
+	channels []byte
+	content  []byte
+}
+
+// walker flattens a tree into its words, keeping the channels in between them
+type walker struct {
+	atoms   []atom
+	current *atom
+	pending []byte
+}
+
+func createWalker() *walker {
+	out := walker{
+		atoms:   []atom{},
+		current: nil,
+		pending: []byte{},
+	}
+
+	return &out
+}
+
+func (app *walker) tree(tree trees.Tree, isInsideLeaf bool) {
+	name := tree.Grammar().Name()
+	isLeaf := !isInsideLeaf && leaves[name]
+	if isLeaf {
+		app.current = &atom{
+			name:    name,
+			content: []byte{},
+		}
+	}
+
+	if tree.Block().HasSuccessful() {
+		elements := tree.Block().Successful().Elements().List()
+		for _, oneElement := range elements {
+			contents := oneElement.Contents().List()
+			for _, oneContent := range contents {
+				if oneContent.IsTree() {
+					app.tree(oneContent.Tree(), isInsideLeaf || isLeaf)
+					continue
+				}
+
+				value := oneContent.Value()
+				if value.HasPrefix() {
+					app.pending = append(app.pending, value.Prefix().Bytes(true)...)
+				}
+
+				app.value(value.Content())
+			}
+		}
+	}
+
+	if tree.HasSuffix() {
+		app.pending = append(app.pending, tree.Suffix().Bytes(true)...)
+	}
+
+	if isLeaf {
+		app.atoms = append(app.atoms, *app.current)
+		app.current = nil
+	}
+}
+
+func (app *walker) value(value byte) {
+	if app.current == nil {
+		app.atoms = append(app.atoms, atom{
+			channels: app.pending,
+			content:  []byte{value},
+		})
+
+		app.pending = []byte{}
+		return
+	}
+
+	// the channels found in the middle of a word are moved in front of the next one:
+	if len(app.current.content) <= 0 {
+		app.current.channels = app.pending
+		app.pending = []byte{}
+	}
+
+	app.current.content = append(app.current.content, value)
+}
@@ -8,4 +8,13 @@ require (
 	github.com/steve-care-software/interpreter v0.0.0-20230108070439-840cfffadfca
 	github.com/steve-care-software/query v0.0.0-20230108070738-7585b8097427
 	github.com/steve-care-software/vm v0.0.0-20230108082722-29f650e568b0
+	golang.org/x/crypto v0.0.0-20220525230936-793ad666bf5e
+	golang.org/x/sys v0.7.0 // indirect
+)
+
+replace (
+	github.com/steve-care-software/ast => ./forks/ast
+	github.com/steve-care-software/interpreter => ./forks/interpreter
+	github.com/steve-care-software/query => ./forks/query
+	github.com/steve-care-software/vm => ./forks/vm
 )
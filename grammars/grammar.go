@@ -193,9 +193,6 @@ func (app *grammar) instructionToken() grammars.Token {
 	return app.tokenFromBlock(
 		"instruction",
 		app.blockFromlines([]grammars.Line{
-			app.lineFromElements([]grammars.Element{
-				app.elementFromToken(app.valueAssignmentToken(), app.cardinalityOnce()),
-			}),
 			app.lineFromElements([]grammars.Element{
 				app.elementFromToken(app.composeAssignmentToken(), app.cardinalityOnce()),
 			}),
@@ -208,6 +205,10 @@ func (app *grammar) instructionToken() grammars.Token {
 			app.lineFromElements([]grammars.Element{
 				app.elementFromToken(app.externalTokenAssignmentToken(), app.cardinalityOnce()),
 			}),
+			// the value is lexed as any bytes, so it is tried last:
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.valueAssignmentToken(), app.cardinalityOnce()),
+			}),
 		}),
 		app.suites(map[string]bool{
 			`myValue: 45;`: true,
@@ -1125,6 +1126,10 @@ func (app *grammar) lineFromContainers(containers []grammars.Container) grammars
 }
 
 func (app *grammar) elementFromEverything(everything grammars.Everything) grammars.Element {
+	return app.elementFromEverythingWithCardinality(everything, app.cardinalityOnce())
+}
+
+func (app *grammar) elementFromEverythingWithCardinality(everything grammars.Everything, cardinality cardinalities.Cardinality) grammars.Element {
 	ins, err := app.instanceBuilder.Create().
 		WithEverything(everything).
 		Now()
@@ -1133,7 +1138,6 @@ func (app *grammar) elementFromEverything(everything grammars.Everything) gramma
 		panic(err)
 	}
 
-	cardinality := app.cardinalityOnce()
 	element, err := app.elementBuilder.Create().
 		WithInstance(ins).
 		WithCardinality(cardinality).
@@ -4,11 +4,20 @@ import (
 	"testing"
 
 	grammar_applications "github.com/steve-care-software/ast/applications"
+	"github.com/steve-care-software/ast/domain/grammars"
+	"github.com/steve-care-software/ast/domain/trees"
 )
 
 func TestGrammar_coverage_Success(t *testing.T) {
+	testCoverage(t, NewGrammar())
+}
+
+func TestInstructionsGrammar_coverage_Success(t *testing.T) {
+	testCoverage(t, NewInstructionsGrammar())
+}
+
+func testCoverage(t *testing.T, ins grammars.Grammar) {
 	grammarApp := grammar_applications.NewApplication()
-	ins := NewGrammar()
 	coverages, err := grammarApp.Coverages(ins)
 	if err != nil {
 		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
@@ -81,3 +90,79 @@ func TestGrammar_withScript_Success(t *testing.T) {
 	}
 
 }
+
+func TestGrammar_withAssignments_Success(t *testing.T) {
+	testCases := map[string]string{
+		"myValue: 45;": "valueAssignment",
+		`
+			myValue: myCompose
+			---
+				valid: myValidCompose;
+			;
+		`: "composeAssignment",
+		`
+			myValue: #myToken
+			---
+				valid: myValidCompose;
+			;
+		`: "everythingAssignment",
+		`
+			myValue: myToken*
+			---
+				valid: myValidCompose;
+			;
+		`: "tokenAssignment",
+		`
+			myValue: cf113f0af255e83f32351a3c32c05fc824e46119f93fb00bfece497421cd4e790b0d682a7bb54d3136c87fdd9222f2ed6a36c904958b0a797b98a22d9d94601c
+			---
+				valid: myValidCompose;
+			;
+		`: "externalTokenAssignment",
+	}
+
+	grammarApp := grammar_applications.NewApplication()
+	ins := NewGrammar()
+	for script, expected := range testCases {
+		treeIns, err := grammarApp.Execute(ins, []byte("@myValue;"+script))
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			continue
+		}
+
+		instruction := findTree(treeIns, "instruction")
+		if instruction == nil {
+			t.Errorf("the script (%s) was expected to contain an instruction", script)
+			continue
+		}
+
+		assignment := instruction.Block().Successful().Elements().List()[0].Contents().List()[0].Tree()
+		if assignment.Grammar().Name() != expected {
+			t.Errorf("the script (%s) was expected to contain a %s, %s returned", script, expected, assignment.Grammar().Name())
+			continue
+		}
+	}
+}
+
+func findTree(tree trees.Tree, name string) trees.Tree {
+	if tree.Grammar().Name() == name {
+		return tree
+	}
+
+	if !tree.Block().HasSuccessful() || !tree.Block().Successful().HasElements() {
+		return nil
+	}
+
+	for _, oneElement := range tree.Block().Successful().Elements().List() {
+		for _, oneContent := range oneElement.Contents().List() {
+			if !oneContent.IsTree() {
+				continue
+			}
+
+			if found := findTree(oneContent.Tree(), name); found != nil {
+				return found
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,1038 @@
+package grammars
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/steve-care-software/ast/domain/grammars"
+)
+
+// Instructions executes the grammar of the instructions interpreted by the virtual machine
+func (app *grammar) Instructions() (grammars.Grammar, error) {
+	root := app.instructionsToken()
+	channels := app.channels()
+	return app.builder.Create().
+		WithRoot(root).
+		WithChannels(channels).
+		Now()
+}
+
+func (app *grammar) instructionsToken() grammars.Token {
+	return app.tokenFromBlock(
+		instructionsTokenName,
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.instructionStatementToken(), app.cardinality(1, nil)),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`
+				module @myModule:0;;
+				@myModule $myApp;;
+				execute $myApp;;
+			`: true,
+			`
+				try {
+					$number = @castToInt($value);;
+				} catch $err {
+					fail $err;;
+				};;
+			`: true,
+		}),
+	)
+}
+
+func (app *grammar) instructionStatementToken() grammars.Token {
+	return app.tokenFromBlock(
+		"instruction",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.moduleDeclarationToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.instructionTerminatorToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.applicationDeclarationToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.instructionTerminatorToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.parameterToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.instructionTerminatorToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.attachmentToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.instructionTerminatorToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.executeToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.instructionTerminatorToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.callToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.instructionTerminatorToken(), app.cardinalityOnce()),
+			}),
+			// a literal is only assigned if the terminator follows it, otherwise the value is assigned as a constant:
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.literalAssignmentToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.instructionTerminatorToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.assignmentToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.instructionTerminatorToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.conditionToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.instructionTerminatorToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.loopToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.instructionTerminatorToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.importToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.instructionTerminatorToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.tryToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.instructionTerminatorToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.failToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.instructionTerminatorToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`module @myModule:0;;`:                            true,
+			`@myModule $myApp;;`:                              true,
+			`-> $myInput;;`:                                   true,
+			`attach $myInput:0 $myApp;;`:                      true,
+			`execute $myApp;;`:                                true,
+			`$myValue = execute $myApp;;`:                     true,
+			`@myModule($myInput);;`:                           true,
+			`$myValue = @myModule($myInput, _, @other($x));;`: true,
+			`if $myCondition {};;`:                            true,
+			`for $item in $list {};;`:                         true,
+			`while $isRunning:10 {};;`:                        true,
+			`import "lib.rodan" as $lib;;`:                    true,
+			`try {} catch $err {};;`:                          true,
+			`fail "invalid input";;`:                          true,
+			`execute $myApp;`:                                 false,
+		}),
+	)
+}
+
+func (app *grammar) moduleDeclarationToken() grammars.Token {
+	return app.tokenFromBlock(
+		"moduleDeclaration",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.allCharacterToken("moduleKeyword", moduleKeyword), app.cardinalityOnce()),
+				app.elementFromToken(app.moduleReferenceToken(), app.cardinalityOnce()),
+				app.elementFromValue([]byte(moduleIndexSeparator)[0]),
+				app.elementFromToken(app.moduleIndexToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`module @myModule:0`:  true,
+			`module @myModule:34`: true,
+			`module @myModule`:    false,
+		}),
+	)
+}
+
+func (app *grammar) moduleReferenceToken() grammars.Token {
+	return app.tokenFromBlock(
+		"moduleReference",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromValue([]byte(moduleReferencePrefix)[0]),
+				app.elementFromToken(app.nameToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`@myModule`: true,
+			`$myModule`: false,
+		}),
+	)
+}
+
+func (app *grammar) moduleIndexToken() grammars.Token {
+	return app.tokenFromBlock(
+		"moduleIndex",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.numberToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`0`:  true,
+			`34`: true,
+		}),
+	)
+}
+
+func (app *grammar) applicationDeclarationToken() grammars.Token {
+	return app.tokenFromBlock(
+		"applicationDeclaration",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.moduleReferenceToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`@myModule $myApp`: true,
+		}),
+	)
+}
+
+func (app *grammar) parameterToken() grammars.Token {
+	return app.tokenFromBlock(
+		"parameter",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.inputParameterToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.outputParameterToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`-> $myInput`:  true,
+			`<- $myOutput`: true,
+		}),
+	)
+}
+
+func (app *grammar) inputParameterToken() grammars.Token {
+	return app.tokenFromBlock(
+		"inputParameter",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.allCharacterToken("inputParameterPrefix", inputParameterPrefix), app.cardinalityOnce()),
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`-> $myInput`: true,
+		}),
+	)
+}
+
+func (app *grammar) outputParameterToken() grammars.Token {
+	return app.tokenFromBlock(
+		"outputParameter",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.allCharacterToken("outputParameterPrefix", outputParameterPrefix), app.cardinalityOnce()),
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`<- $myOutput`: true,
+		}),
+	)
+}
+
+func (app *grammar) attachmentToken() grammars.Token {
+	return app.tokenFromBlock(
+		"attachment",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.allCharacterToken("attachKeyword", attachKeyword), app.cardinalityOnce()),
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+				app.elementFromValue([]byte(attachmentTargetSeparator)[0]),
+				app.elementFromToken(app.attachmentTargetToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`attach $myInput:0 $myApp`: true,
+		}),
+	)
+}
+
+func (app *grammar) attachmentTargetToken() grammars.Token {
+	return app.tokenFromBlock(
+		"attachmentTarget",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.numberToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`0`:  true,
+			`12`: true,
+		}),
+	)
+}
+
+func (app *grammar) executeToken() grammars.Token {
+	return app.tokenFromBlock(
+		"execute",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.allCharacterToken("executeKeyword", executeKeyword), app.cardinalityOnce()),
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`execute $myApp`: true,
+		}),
+	)
+}
+
+func (app *grammar) assignmentToken() grammars.Token {
+	return app.tokenFromBlock(
+		"assignment",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.variableAssignmentToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.executionAssignmentToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.instructionsAssignmentToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.loopAssignmentToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.callAssignmentToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.constantAssignmentToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`$myValue = $myInput`:              true,
+			`$myValue = execute $myApp`:        true,
+			`$myValue = for $item in $list {}`: true,
+			`$myValue = @myModule($myInput)`:   true,
+			`$myValue = this is a value`:       true,
+		}),
+	)
+}
+
+func (app *grammar) variableAssignmentToken() grammars.Token {
+	return app.tokenFromBlock(
+		"variableAssignment",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+				app.elementFromValue([]byte(assignmentOperator)[0]),
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`$myValue = $myInput`: true,
+		}),
+	)
+}
+
+func (app *grammar) executionAssignmentToken() grammars.Token {
+	return app.tokenFromBlock(
+		"executionAssignment",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+				app.elementFromValue([]byte(assignmentOperator)[0]),
+				app.elementFromToken(app.executeToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`$myValue = execute $myApp`: true,
+		}),
+	)
+}
+
+func (app *grammar) instructionsAssignmentToken() grammars.Token {
+	return app.tokenFromBlock(
+		"instructionsAssignment",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+				app.elementFromValue([]byte(assignmentOperator)[0]),
+				app.elementFromValue([]byte(instructionsPrefix)[0]),
+				app.elementFromRecursiveToken(instructionsTokenName, app.cardinalityOnce()),
+				app.elementFromValue([]byte(instructionsSuffix)[0]),
+			}),
+		}),
+		nil,
+	)
+}
+
+func (app *grammar) loopAssignmentToken() grammars.Token {
+	return app.tokenFromBlock(
+		"loopAssignment",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+				app.elementFromValue([]byte(assignmentOperator)[0]),
+				app.elementFromToken(app.loopToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`$myValues = for $item in $list {}`:  true,
+			`$myValues = while $isRunning:10 {}`: true,
+		}),
+	)
+}
+
+func (app *grammar) callAssignmentToken() grammars.Token {
+	return app.tokenFromBlock(
+		"callAssignment",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+				app.elementFromValue([]byte(assignmentOperator)[0]),
+				app.elementFromToken(app.callToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`$myValue = @myModule()`:                    true,
+			`$myValue = @myModule($myInput, _, $other)`: true,
+			`$myValue = @myModule(@other($myInput))`:    true,
+		}),
+	)
+}
+
+func (app *grammar) literalAssignmentToken() grammars.Token {
+	return app.tokenFromBlock(
+		"literalAssignment",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+				app.elementFromValue([]byte(assignmentOperator)[0]),
+				app.elementFromToken(app.literalToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`$myValue = 42`:      true,
+			`$myValue = "hello"`: true,
+			`$myValue = [1, 2]`:  true,
+			`$myValue = hello`:   false,
+		}),
+	)
+}
+
+func (app *grammar) literalToken() grammars.Token {
+	return app.tokenFromBlock(
+		"literal",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.literalValueToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`42`:                            true,
+			`[]`:                            true,
+			`[1, [true, "a"], 0x00]`:        true,
+			`["a;;b", "quote: \"", "\x3b"]`: true,
+			`[1, 2`:                         false,
+		}),
+	)
+}
+
+func (app *grammar) literalValueToken() grammars.Token {
+	return app.tokenFromBlock(
+		"literalValue",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.stringLiteralToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.bytesLiteralToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.numberLiteralToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.allCharacterToken("trueLiteral", trueKeyword), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.allCharacterToken("falseLiteral", falseKeyword), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.allCharacterToken("emptyListLiteral", listLiteralPrefix+listLiteralSuffix), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.listLiteralToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`"hello"`: true,
+			`0xff`:    true,
+			`-12`:     true,
+			`true`:    true,
+			`false`:   true,
+			`hello`:   false,
+		}),
+	)
+}
+
+func (app *grammar) stringLiteralToken() grammars.Token {
+	// the escape is consumed by the lexer only in front of a delimiter, the other escape sequences are decoded by the query:
+	max := uint(1)
+	return app.tokenFromBlock(
+		"stringLiteral",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromValue([]byte(stringLiteralDelimiter)[0]),
+				app.elementFromEverythingWithCardinality(
+					app.everything(
+						"everythingExceptStringLiteralDelimiter",
+						app.allCharacterToken("stringLiteralDelimiter", stringLiteralDelimiter),
+						app.allCharacterToken("stringLiteralEscape", stringLiteralEscape),
+					),
+					app.cardinality(0, &max),
+				),
+				app.elementFromValue([]byte(stringLiteralDelimiter)[0]),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`""`:               true,
+			`"hello world"`:    true,
+			`"line\nbreak"`:    true,
+			`"a \"quote\" ;;"`: true,
+			`"unterminated`:    false,
+		}),
+	)
+}
+
+func (app *grammar) bytesLiteralToken() grammars.Token {
+	return app.tokenFromBlock(
+		"bytesLiteral",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.allCharacterToken("bytesLiteralPrefix", bytesLiteralPrefix), app.cardinalityOnce()),
+				app.elementFromToken(app.charactersToken("hexCharacters", hexCharacters), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`0x00`:     true,
+			`0xdeadBE`: true,
+			`0x`:       false,
+		}),
+	)
+}
+
+func (app *grammar) numberLiteralToken() grammars.Token {
+	max := uint(1)
+	return app.tokenFromBlock(
+		"numberLiteral",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.anyCharacterToken("numberLiteralSign", numberLiteralSigns), app.cardinality(0, &max)),
+				app.elementFromToken(app.charactersToken("digitCharacters", digitCharacters), app.cardinalityOnce()),
+				app.elementFromToken(app.numberLiteralFractionToken(), app.cardinality(0, &max)),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`0`:    true,
+			`42`:   true,
+			`-42`:  true,
+			`+42`:  true,
+			`3.14`: true,
+			`-0.5`: true,
+			`.5`:   false,
+		}),
+	)
+}
+
+func (app *grammar) numberLiteralFractionToken() grammars.Token {
+	return app.tokenFromBlock(
+		"numberLiteralFraction",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromValue([]byte(numberLiteralFractionSeparator)[0]),
+				app.elementFromToken(app.charactersToken("digitCharacters", digitCharacters), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`.5`:  true,
+			`.25`: true,
+		}),
+	)
+}
+
+func (app *grammar) listLiteralToken() grammars.Token {
+	return app.tokenFromBlock(
+		"listLiteral",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromValue([]byte(listLiteralPrefix)[0]),
+				app.elementFromToken(app.listLiteralElementsToken(), app.cardinalityOnce()),
+				app.elementFromValue([]byte(listLiteralSuffix)[0]),
+			}),
+		}),
+		nil,
+	)
+}
+
+func (app *grammar) listLiteralElementsToken() grammars.Token {
+	return app.tokenFromBlock(
+		"listLiteralElements",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromRecursiveToken("literal", app.cardinalityOnce()),
+				app.elementFromToken(app.listLiteralNextElementToken(), app.cardinality(0, nil)),
+			}),
+		}),
+		nil,
+	)
+}
+
+func (app *grammar) listLiteralNextElementToken() grammars.Token {
+	return app.tokenFromBlock(
+		"listLiteralNextElement",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromValue([]byte(listLiteralSeparator)[0]),
+				app.elementFromRecursiveToken("literal", app.cardinalityOnce()),
+			}),
+		}),
+		nil,
+	)
+}
+
+func (app *grammar) constantAssignmentToken() grammars.Token {
+	return app.tokenFromBlock(
+		"constantAssignment",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+				app.elementFromValue([]byte(assignmentOperator)[0]),
+				app.elementFromEverything(
+					app.everythingWithoutEscape(
+						"everythingExceptEndOfLine",
+						app.allCharacterToken("endOfInstruction", instructionTerminator),
+					),
+				),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`$myValue = this is a value;;`: true,
+		}),
+	)
+}
+
+func (app *grammar) callToken() grammars.Token {
+	return app.tokenFromBlock(
+		"call",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.callExpressionToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`@myModule()`:                           true,
+			`@myModule($myInput)`:                   true,
+			`@myModule($myInput, _, $other)`:        true,
+			`@myModule(@other(@last($myInput)), _)`: true,
+			`@myModule`:                             false,
+			`@myModule($myInput`:                    false,
+		}),
+	)
+}
+
+func (app *grammar) callExpressionToken() grammars.Token {
+	max := uint(1)
+	return app.tokenFromBlock(
+		"callExpression",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.moduleReferenceToken(), app.cardinalityOnce()),
+				app.elementFromValue([]byte(callArgumentsPrefix)[0]),
+				app.elementFromToken(app.callArgumentsToken(), app.cardinality(0, &max)),
+				app.elementFromValue([]byte(callArgumentsSuffix)[0]),
+			}),
+		}),
+		nil,
+	)
+}
+
+func (app *grammar) callArgumentsToken() grammars.Token {
+	return app.tokenFromBlock(
+		"callArguments",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.callArgumentToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.callNextArgumentToken(), app.cardinality(0, nil)),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`$myInput`:            true,
+			`$myInput, _, $other`: true,
+		}),
+	)
+}
+
+func (app *grammar) callNextArgumentToken() grammars.Token {
+	return app.tokenFromBlock(
+		"callNextArgument",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromValue([]byte(callArgumentSeparator)[0]),
+				app.elementFromToken(app.callArgumentToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`, $myInput`: true,
+			`, _`:        true,
+		}),
+	)
+}
+
+func (app *grammar) callArgumentToken() grammars.Token {
+	return app.tokenFromBlock(
+		"callArgument",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.allCharacterToken("skippedCallArgument", skippedCallArgument), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromRecursiveToken("call", app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`$myInput`: true,
+			`_`:        true,
+		}),
+	)
+}
+
+func (app *grammar) conditionToken() grammars.Token {
+	max := uint(1)
+	return app.tokenFromBlock(
+		"condition",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.allCharacterToken("ifKeyword", ifKeyword), app.cardinalityOnce()),
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.conditionBranchToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.elseBranchToken(), app.cardinality(0, &max)),
+			}),
+		}),
+		nil,
+	)
+}
+
+func (app *grammar) elseBranchToken() grammars.Token {
+	return app.tokenFromBlock(
+		"elseBranch",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.allCharacterToken("elseKeyword", elseKeyword), app.cardinalityOnce()),
+				app.elementFromToken(app.conditionBranchToken(), app.cardinalityOnce()),
+			}),
+		}),
+		nil,
+	)
+}
+
+func (app *grammar) conditionBranchToken() grammars.Token {
+	return app.instructionsBlockToken("conditionBranch")
+}
+
+func (app *grammar) importToken() grammars.Token {
+	return app.tokenFromBlock(
+		"import",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.allCharacterToken("importKeyword", importKeyword), app.cardinalityOnce()),
+				app.elementFromToken(app.importPathToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.allCharacterToken("asKeyword", asKeyword), app.cardinalityOnce()),
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`import "lib.rodan" as $lib`:            true,
+			`import "scripts/my lib.rodan" as $lib`: true,
+			`import lib.rodan as $lib`:              false,
+			`import "lib.rodan" $lib`:               false,
+		}),
+	)
+}
+
+func (app *grammar) importPathToken() grammars.Token {
+	return app.tokenFromBlock(
+		"importPath",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromValue([]byte(importPathDelimiter)[0]),
+				app.elementFromEverything(
+					app.everythingWithoutEscape(
+						"everythingExceptImportPathDelimiter",
+						app.allCharacterToken("importPathDelimiter", importPathDelimiter),
+					),
+				),
+				app.elementFromValue([]byte(importPathDelimiter)[0]),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`"lib.rodan"`: true,
+			`""`:          false,
+		}),
+	)
+}
+
+func (app *grammar) tryToken() grammars.Token {
+	return app.tokenFromBlock(
+		"try",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.allCharacterToken("tryKeyword", tryKeyword), app.cardinalityOnce()),
+				app.elementFromToken(app.instructionsBlockToken("tryBody"), app.cardinalityOnce()),
+				app.elementFromToken(app.allCharacterToken("catchKeyword", catchKeyword), app.cardinalityOnce()),
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.instructionsBlockToken("catchBody"), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`try {} catch $err {}`: true,
+			`try {} catch {}`:      false,
+			`try {}`:               false,
+		}),
+	)
+}
+
+func (app *grammar) failToken() grammars.Token {
+	return app.tokenFromBlock(
+		"fail",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.allCharacterToken("failKeyword", failKeyword), app.cardinalityOnce()),
+				app.elementFromToken(app.failValueToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`fail $err`:                   true,
+			`fail "the input is invalid"`: true,
+			`fail`:                        false,
+		}),
+	)
+}
+
+func (app *grammar) failValueToken() grammars.Token {
+	return app.tokenFromBlock(
+		"failValue",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.literalToken(), app.cardinalityOnce()),
+			}),
+		}),
+		nil,
+	)
+}
+
+func (app *grammar) loopToken() grammars.Token {
+	return app.tokenFromBlock(
+		"loop",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.forLoopToken(), app.cardinalityOnce()),
+			}),
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.whileLoopToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`for $item in $list {}`:  true,
+			`while $isRunning:10 {}`: true,
+			`while $isRunning {}`:    false,
+			`for $item $list {}`:     false,
+		}),
+	)
+}
+
+func (app *grammar) forLoopToken() grammars.Token {
+	return app.tokenFromBlock(
+		"forLoop",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.allCharacterToken("forKeyword", forKeyword), app.cardinalityOnce()),
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.allCharacterToken("inKeyword", inKeyword), app.cardinalityOnce()),
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.loopBodyToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`for $i in $list {}`:    true,
+			`for $item in $list {}`: true,
+			`for $itemin $list {}`:  false,
+		}),
+	)
+}
+
+func (app *grammar) whileLoopToken() grammars.Token {
+	return app.tokenFromBlock(
+		"whileLoop",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.allCharacterToken("whileKeyword", whileKeyword), app.cardinalityOnce()),
+				app.elementFromToken(app.variableReferenceToken(), app.cardinalityOnce()),
+				app.elementFromValue([]byte(loopMaximumSeparator)[0]),
+				app.elementFromToken(app.loopMaximumToken(), app.cardinalityOnce()),
+				app.elementFromToken(app.loopBodyToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`while $isRunning:10 {}`: true,
+		}),
+	)
+}
+
+func (app *grammar) loopMaximumToken() grammars.Token {
+	return app.tokenFromBlock(
+		"loopMaximum",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.numberToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`10`: true,
+		}),
+	)
+}
+
+func (app *grammar) loopBodyToken() grammars.Token {
+	return app.instructionsBlockToken("loopBody")
+}
+
+func (app *grammar) instructionsBlockToken(name string) grammars.Token {
+	max := uint(1)
+	return app.tokenFromBlock(
+		name,
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromValue([]byte(instructionsPrefix)[0]),
+				app.elementFromRecursiveToken(instructionsTokenName, app.cardinality(0, &max)),
+				app.elementFromValue([]byte(instructionsSuffix)[0]),
+			}),
+		}),
+		nil,
+	)
+}
+
+func (app *grammar) instructionTerminatorToken() grammars.Token {
+	return app.allCharacterToken("instructionTerminator", instructionTerminator)
+}
+
+func (app *grammar) variableReferenceToken() grammars.Token {
+	return app.tokenFromBlock(
+		"variableReference",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromValue([]byte(variableReferencePrefix)[0]),
+				app.elementFromToken(app.nameToken(), app.cardinalityOnce()),
+			}),
+		}),
+		app.suites(map[string]bool{
+			`$myVariable`: true,
+			`@myVariable`: false,
+		}),
+	)
+}
+
+func (app *grammar) nameToken() grammars.Token {
+	// the characters following the first letter are lexed without channels, so that a name ends on the first space:
+	max := uint(1)
+	lines := []grammars.Line{}
+	for _, oneLetter := range []byte(lowerCaseLetters) {
+		lines = append(lines, app.lineFromElements([]grammars.Element{
+			app.elementFromValue(oneLetter),
+			app.elementFromEverythingWithCardinality(
+				app.everythingWithoutEscape(
+					"nameCharacters",
+					app.nonNameCharacterToken(),
+				),
+				app.cardinality(0, &max),
+			),
+		}))
+	}
+
+	return app.tokenFromBlock(
+		"name",
+		app.blockFromlines(lines),
+		app.suites(map[string]bool{
+			"m":           true,
+			"myVariable":  true,
+			"myVariable2": true,
+			"MyVariable":  false,
+			"0Variable":   false,
+		}),
+	)
+}
+
+func (app *grammar) nonNameCharacterToken() grammars.Token {
+	return app.exceptCharactersToken("nonNameCharacter", nameCharacters)
+}
+
+func (app *grammar) charactersToken(name string, characters string) grammars.Token {
+	// the characters following the first one are lexed without channels, so that the sequence ends on the first space:
+	max := uint(1)
+	lines := []grammars.Line{}
+	for _, oneCharacter := range []byte(characters) {
+		lines = append(lines, app.lineFromElements([]grammars.Element{
+			app.elementFromValue(oneCharacter),
+			app.elementFromEverythingWithCardinality(
+				app.everythingWithoutEscape(
+					fmt.Sprintf("%sRemaining", name),
+					app.exceptCharactersToken(fmt.Sprintf("%sException", name), characters),
+				),
+				app.cardinality(0, &max),
+			),
+		}))
+	}
+
+	return app.tokenFromBlock(
+		name,
+		app.blockFromlines(lines),
+		nil,
+	)
+}
+
+func (app *grammar) exceptCharactersToken(name string, characters string) grammars.Token {
+	lines := []grammars.Line{}
+	for i := 0; i <= 255; i++ {
+		value := byte(i)
+		if strings.IndexByte(characters, value) >= 0 {
+			continue
+		}
+
+		lines = append(lines, app.lineFromElements([]grammars.Element{
+			app.elementFromValue(value),
+		}))
+	}
+
+	return app.tokenFromBlock(
+		name,
+		app.blockFromlines(lines),
+		nil,
+	)
+}
+
+func (app *grammar) numberToken() grammars.Token {
+	return app.tokenFromBlock(
+		"number",
+		app.blockFromlines([]grammars.Line{
+			app.lineFromElements([]grammars.Element{
+				app.elementFromToken(app.anyNumberToken(), app.cardinality(1, nil)),
+			}),
+		}),
+		app.suites(map[string]bool{
+			"0":   true,
+			"123": true,
+			"a":   false,
+		}),
+	)
+}
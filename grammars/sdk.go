@@ -37,6 +37,51 @@ const instructionSuffix = ";"
 const externalTokenPrefix = "{"
 const externalTokenSuffix = "{"
 
+const instructionsTokenName = "instructions"
+const instructionTerminator = ";;"
+const moduleKeyword = "module"
+const attachKeyword = "attach"
+const executeKeyword = "execute"
+const ifKeyword = "if"
+const elseKeyword = "else"
+const forKeyword = "for"
+const inKeyword = "in"
+const whileKeyword = "while"
+const loopMaximumSeparator = ":"
+const importKeyword = "import"
+const asKeyword = "as"
+const tryKeyword = "try"
+const catchKeyword = "catch"
+const failKeyword = "fail"
+const importPathDelimiter = "\""
+const callArgumentsPrefix = "("
+const callArgumentsSuffix = ")"
+const callArgumentSeparator = ","
+const skippedCallArgument = "_"
+const trueKeyword = "true"
+const falseKeyword = "false"
+const stringLiteralDelimiter = "\""
+const stringLiteralEscape = "\\"
+const bytesLiteralPrefix = "0x"
+const numberLiteralSigns = "-+"
+const numberLiteralFractionSeparator = "."
+const listLiteralPrefix = "["
+const listLiteralSuffix = "]"
+const listLiteralSeparator = ","
+const digitCharacters = "0123456789"
+const hexCharacters = digitCharacters + "abcdefABCDEF"
+const moduleReferencePrefix = "@"
+const variableReferencePrefix = "$"
+const inputParameterPrefix = "->"
+const outputParameterPrefix = "<-"
+const moduleIndexSeparator = ":"
+const attachmentTargetSeparator = ":"
+const assignmentOperator = "="
+const instructionsPrefix = "{"
+const instructionsSuffix = "}"
+const lowerCaseLetters = "abcdefghijklmnopqrstuvwxyz"
+const nameCharacters = lowerCaseLetters + "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
 // NewGrammar creates a new grammar instance
 func NewGrammar() grammars.Grammar {
 	builder := grammars.NewBuilder()
@@ -83,3 +128,33 @@ func NewGrammar() grammars.Grammar {
 
 	return ins
 }
+
+// NewInstructionsGrammar creates a new grammar instance lexing the instructions interpreted by the virtual machine
+func NewInstructionsGrammar() grammars.Grammar {
+	grammarIns := createGrammar(
+		grammars.NewBuilder(),
+		grammars.NewChannelsBuilder(),
+		grammars.NewChannelBuilder(),
+		grammars.NewInstanceBuilder(),
+		grammars.NewEverythingBuilder(),
+		grammars.NewTokensBuilder(),
+		grammars.NewTokenBuilder(),
+		grammars.NewSuitesBuilder(),
+		grammars.NewSuiteBuilder(),
+		grammars.NewBlockBuilder(),
+		grammars.NewLineBuilder(),
+		grammars.NewContainerBuilder(),
+		grammars.NewElementBuilder(),
+		grammars.NewComposeBuilder(),
+		grammars.NewComposeElementBuilder(),
+		values.NewBuilder(),
+		cardinalities.NewBuilder(),
+	)
+
+	ins, err := grammarIns.Instructions()
+	if err != nil {
+		panic(err)
+	}
+
+	return ins
+}
@@ -0,0 +1,106 @@
+package limits
+
+import (
+	"errors"
+	"time"
+)
+
+type builder struct {
+	pDepth        *uint
+	pCallDepth    *uint
+	pInstructions *uint
+	pReadBytes    *uint
+	pWrittenBytes *uint
+	pHandles      *uint
+	pDeadline     *time.Duration
+}
+
+func createBuilder() Builder {
+	out := builder{
+		pDepth:        nil,
+		pCallDepth:    nil,
+		pInstructions: nil,
+		pReadBytes:    nil,
+		pWrittenBytes: nil,
+		pHandles:      nil,
+		pDeadline:     nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *builder) Create() Builder {
+	return createBuilder()
+}
+
+// WithDepth adds a maximum nested vm depth to the builder
+func (app *builder) WithDepth(depth uint) Builder {
+	app.pDepth = &depth
+	return app
+}
+
+// WithCallDepth adds a maximum nested callable depth to the builder
+func (app *builder) WithCallDepth(callDepth uint) Builder {
+	app.pCallDepth = &callDepth
+	return app
+}
+
+// WithInstructions adds a maximum amount of executed instructions to the builder
+func (app *builder) WithInstructions(instructions uint) Builder {
+	app.pInstructions = &instructions
+	return app
+}
+
+// WithReadBytes adds a maximum amount of read bytes to the builder
+func (app *builder) WithReadBytes(readBytes uint) Builder {
+	app.pReadBytes = &readBytes
+	return app
+}
+
+// WithWrittenBytes adds a maximum amount of written bytes to the builder
+func (app *builder) WithWrittenBytes(writtenBytes uint) Builder {
+	app.pWrittenBytes = &writtenBytes
+	return app
+}
+
+// WithHandles adds a maximum amount of open handles to the builder
+func (app *builder) WithHandles(handles uint) Builder {
+	app.pHandles = &handles
+	return app
+}
+
+// WithDeadline adds a deadline, relative to the beginning of an interpretation, to the builder
+func (app *builder) WithDeadline(deadline time.Duration) Builder {
+	app.pDeadline = &deadline
+	return app
+}
+
+// Now builds a new Limits instance
+func (app *builder) Now() (Limits, error) {
+	if app.pDeadline != nil && *app.pDeadline <= 0 {
+		return nil, errors.New("the deadline must be greater than zero in order to build a Limits instance")
+	}
+
+	isEmpty := app.pDepth == nil &&
+		app.pCallDepth == nil &&
+		app.pInstructions == nil &&
+		app.pReadBytes == nil &&
+		app.pWrittenBytes == nil &&
+		app.pHandles == nil &&
+		app.pDeadline == nil
+
+	if isEmpty {
+		return nil, errors.New("at least one limit is mandatory in order to build a Limits instance")
+	}
+
+	return createLimits(
+		app.pDepth,
+		app.pCallDepth,
+		app.pInstructions,
+		app.pReadBytes,
+		app.pWrittenBytes,
+		app.pHandles,
+		app.pDeadline,
+	), nil
+}
@@ -0,0 +1,105 @@
+package limits
+
+import "time"
+
+type limits struct {
+	pDepth        *uint
+	pCallDepth    *uint
+	pInstructions *uint
+	pReadBytes    *uint
+	pWrittenBytes *uint
+	pHandles      *uint
+	pDeadline     *time.Duration
+}
+
+func createLimits(
+	pDepth *uint,
+	pCallDepth *uint,
+	pInstructions *uint,
+	pReadBytes *uint,
+	pWrittenBytes *uint,
+	pHandles *uint,
+	pDeadline *time.Duration,
+) Limits {
+	out := limits{
+		pDepth:        pDepth,
+		pCallDepth:    pCallDepth,
+		pInstructions: pInstructions,
+		pReadBytes:    pReadBytes,
+		pWrittenBytes: pWrittenBytes,
+		pHandles:      pHandles,
+		pDeadline:     pDeadline,
+	}
+
+	return &out
+}
+
+// HasDepth returns true if there is a maximum nested vm depth, false otherwise
+func (obj *limits) HasDepth() bool {
+	return obj.pDepth != nil
+}
+
+// Depth returns the maximum nested vm depth, if any
+func (obj *limits) Depth() *uint {
+	return obj.pDepth
+}
+
+// HasCallDepth returns true if there is a maximum nested callable depth, false otherwise
+func (obj *limits) HasCallDepth() bool {
+	return obj.pCallDepth != nil
+}
+
+// CallDepth returns the maximum nested callable depth, if any
+func (obj *limits) CallDepth() *uint {
+	return obj.pCallDepth
+}
+
+// HasInstructions returns true if there is a maximum amount of executed instructions, false otherwise
+func (obj *limits) HasInstructions() bool {
+	return obj.pInstructions != nil
+}
+
+// Instructions returns the maximum amount of executed instructions, if any
+func (obj *limits) Instructions() *uint {
+	return obj.pInstructions
+}
+
+// HasReadBytes returns true if there is a maximum amount of read bytes, false otherwise
+func (obj *limits) HasReadBytes() bool {
+	return obj.pReadBytes != nil
+}
+
+// ReadBytes returns the maximum amount of read bytes, if any
+func (obj *limits) ReadBytes() *uint {
+	return obj.pReadBytes
+}
+
+// HasWrittenBytes returns true if there is a maximum amount of written bytes, false otherwise
+func (obj *limits) HasWrittenBytes() bool {
+	return obj.pWrittenBytes != nil
+}
+
+// WrittenBytes returns the maximum amount of written bytes, if any
+func (obj *limits) WrittenBytes() *uint {
+	return obj.pWrittenBytes
+}
+
+// HasHandles returns true if there is a maximum amount of open handles, false otherwise
+func (obj *limits) HasHandles() bool {
+	return obj.pHandles != nil
+}
+
+// Handles returns the maximum amount of open handles, if any
+func (obj *limits) Handles() *uint {
+	return obj.pHandles
+}
+
+// HasDeadline returns true if there is a deadline, false otherwise
+func (obj *limits) HasDeadline() bool {
+	return obj.pDeadline != nil
+}
+
+// Deadline returns the deadline, if any
+func (obj *limits) Deadline() *time.Duration {
+	return obj.pDeadline
+}
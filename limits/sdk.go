@@ -0,0 +1,78 @@
+package limits
+
+import (
+	"errors"
+	"time"
+
+	interpreter_applications "github.com/steve-care-software/interpreter/applications"
+)
+
+// DefaultDepth represents the maximum nested vm depth of an interpretation whose limits do not define one
+const DefaultDepth = 64
+
+// ErrDepthExceeded is returned when the maximum nested vm depth is exceeded
+var ErrDepthExceeded = errors.New("the maximum nested vm depth has been exceeded")
+
+// ErrInstructionsExceeded is returned when the maximum amount of executed instructions is exceeded
+var ErrInstructionsExceeded = errors.New("the maximum amount of executed instructions has been exceeded")
+
+// ErrReadBytesExceeded is returned when the maximum amount of read bytes is exceeded
+var ErrReadBytesExceeded = errors.New("the maximum amount of read bytes has been exceeded")
+
+// ErrWrittenBytesExceeded is returned when the maximum amount of written bytes is exceeded
+var ErrWrittenBytesExceeded = errors.New("the maximum amount of written bytes has been exceeded")
+
+// ErrHandlesExceeded is returned when the maximum amount of open handles is exceeded
+var ErrHandlesExceeded = errors.New("the maximum amount of open handles has been exceeded")
+
+// ErrDeadlineExceeded is returned when the deadline of an interpretation is exceeded
+var ErrDeadlineExceeded = errors.New("the deadline of the interpretation has been exceeded")
+
+// Errors returns every error returned when a limit is exceeded
+func Errors() []error {
+	return []error{
+		ErrDepthExceeded,
+		ErrInstructionsExceeded,
+		ErrReadBytesExceeded,
+		ErrWrittenBytesExceeded,
+		ErrHandlesExceeded,
+		ErrDeadlineExceeded,
+		interpreter_applications.ErrCallDepthExceeded,
+	}
+}
+
+// NewBuilder creates a new builder instance
+func NewBuilder() Builder {
+	return createBuilder()
+}
+
+// Builder represents a limits builder
+type Builder interface {
+	Create() Builder
+	WithDepth(depth uint) Builder
+	WithCallDepth(callDepth uint) Builder
+	WithInstructions(instructions uint) Builder
+	WithReadBytes(readBytes uint) Builder
+	WithWrittenBytes(writtenBytes uint) Builder
+	WithHandles(handles uint) Builder
+	WithDeadline(deadline time.Duration) Builder
+	Now() (Limits, error)
+}
+
+// Limits represents the resource limits of an interpretation
+type Limits interface {
+	HasDepth() bool
+	Depth() *uint
+	HasCallDepth() bool
+	CallDepth() *uint
+	HasInstructions() bool
+	Instructions() *uint
+	HasReadBytes() bool
+	ReadBytes() *uint
+	HasWrittenBytes() bool
+	WrittenBytes() *uint
+	HasHandles() bool
+	Handles() *uint
+	HasDeadline() bool
+	Deadline() *time.Duration
+}
@@ -0,0 +1,398 @@
+package linters
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/steve-care-software/interpreter/domain/instructions"
+	"github.com/steve-care-software/interpreter/domain/instructions/attachments"
+)
+
+// analysis walks the instructions of a program in order, collecting the problems found in them
+type analysis struct {
+	inputs       map[uint]uint
+	blocks       uint
+	instructions uint
+	diagnostics  []Diagnostic
+}
+
+func createAnalysis(
+	inputs map[uint]uint,
+) *analysis {
+	out := analysis{
+		inputs:       inputs,
+		blocks:       0,
+		instructions: 0,
+		diagnostics:  []Diagnostic{},
+	}
+
+	return &out
+}
+
+func (app *analysis) program(list []instructions.Instruction, parent *scope, position []uint) {
+	current := createScope(parent, false)
+	app.block(list, current, position)
+	app.close(current)
+}
+
+// close reports the problems of the variables owned by a scope once all of its instructions are analyzed
+func (app *analysis) close(current *scope) {
+	for _, oneVariable := range current.variables {
+		if isHidden(oneVariable.name) {
+			continue
+		}
+
+		if oneVariable.isInput {
+			if !oneVariable.isRead {
+				str := fmt.Sprintf("the input (name: %s) is never used", oneVariable.name)
+				app.report(RuleUnusedInput, str, oneVariable.name, oneVariable.position)
+			}
+
+			continue
+		}
+
+		if oneVariable.isOutput {
+			if !oneVariable.isAssigned {
+				str := fmt.Sprintf("the output (name: %s) is never assigned", oneVariable.name)
+				app.report(RuleUnassignedOutput, str, oneVariable.name, oneVariable.position)
+			}
+
+			continue
+		}
+
+		if !oneVariable.isRead {
+			str := fmt.Sprintf("the variable (name: %s) is assigned but never used", oneVariable.name)
+			app.report(RuleUnusedVariable, str, oneVariable.name, oneVariable.position)
+		}
+	}
+}
+
+func (app *analysis) block(list []instructions.Instruction, current *scope, position []uint) {
+	app.blocks++
+	block := app.blocks
+	isContinued := false
+	for idx, oneInstruction := range list {
+		// the instructions lowered from a single instruction of the script share its index:
+		if !isContinued {
+			app.instructions++
+		}
+
+		app.instruction(oneInstruction, current, block, location{
+			position:    appendPosition(position, idx),
+			instruction: app.instructions - 1,
+		})
+
+		isContinued = isLowered(oneInstruction)
+	}
+}
+
+func (app *analysis) instruction(instruction instructions.Instruction, current *scope, block uint, position location) {
+	if instruction.IsModule() {
+		module := instruction.Module()
+		current.modules[string(module.Name())] = module.Index()
+		return
+	}
+
+	if instruction.IsApplication() {
+		declaration := instruction.Application()
+		declared := application{}
+		moduleName := declaration.Module()
+		if index, ok := current.fetchModule(string(moduleName)); ok {
+			if amount, ok := app.inputs[index]; ok {
+				declared.inputs = &amount
+			}
+		} else {
+			str := fmt.Sprintf("the module (name: %s) is used by the application (name: %s) but never declared", moduleName, declaration.Name())
+			app.report(RuleUndefinedModule, str, moduleName, position)
+		}
+
+		current.applications[string(declaration.Name())] = &declared
+		return
+	}
+
+	if instruction.IsParameter() {
+		parameter := instruction.Parameter()
+		name := parameter.Name()
+		current.owner().variables[string(name)] = &variable{
+			name:       name,
+			position:   position,
+			isInput:    parameter.IsInput(),
+			isOutput:   !parameter.IsInput(),
+			isAssigned: parameter.IsInput(),
+		}
+
+		return
+	}
+
+	if instruction.IsAssignment() {
+		app.assignment(instruction.Assignment(), current, block, position)
+		return
+	}
+
+	if instruction.IsAttachment() {
+		app.attachment(instruction.Attachment(), current, position)
+		return
+	}
+
+	if instruction.IsExecution() {
+		app.application(instruction.Execution(), current, position)
+		return
+	}
+
+	if instruction.IsCondition() {
+		condition := instruction.Condition()
+		app.read(condition.Variable(), current, position)
+		if condition.HasThen() {
+			app.block(condition.Then().List(), createScope(current, true), position.position)
+		}
+
+		if condition.HasElse() {
+			app.block(condition.Else().List(), createScope(current, true), position.position)
+		}
+
+		return
+	}
+
+	if instruction.IsLoop() {
+		app.loop(instruction.Loop(), current, position)
+		return
+	}
+
+	if instruction.IsImport() {
+		importIns := instruction.Import()
+		assigned := app.assign(importIns.Variable(), current, block, position)
+		current.applications[string(importIns.Variable())] = &application{
+			variable: assigned,
+		}
+
+		return
+	}
+
+	if instruction.IsTry() {
+		tryIns := instruction.Try()
+		if tryIns.HasInstructions() {
+			app.block(tryIns.Instructions().List(), createScope(current, true), position.position)
+		}
+
+		if tryIns.HasCatch() {
+			// the error is only referenceable inside the catch branch:
+			catch := createScope(current, true)
+			catch.variables[string(tryIns.Variable())] = &variable{
+				name:       tryIns.Variable(),
+				position:   position,
+				isAssigned: true,
+			}
+
+			app.block(tryIns.Catch().List(), catch, position.position)
+		}
+
+		return
+	}
+
+	if instruction.IsFail() {
+		app.read(instruction.Fail(), current, position)
+	}
+}
+
+func (app *analysis) assignment(assignment instructions.Assignment, current *scope, block uint, position location) {
+	name := assignment.Variable()
+	value := assignment.Value()
+	if value.IsInstructions() {
+		// the callable is declared before its instructions so that it can execute itself:
+		list := value.Instructions().List()
+		assigned := app.assign(name, current, block, position)
+		amount := countInputs(list)
+		current.applications[string(name)] = &application{
+			inputs:   &amount,
+			variable: assigned,
+		}
+
+		app.program(list, current, position.position)
+		return
+	}
+
+	if value.IsVariable() {
+		app.read(value.Variable(), current, position)
+	}
+
+	if value.IsExecution() {
+		app.application(value.Execution(), current, position)
+	}
+
+	if value.IsLoop() {
+		app.loop(value.Loop(), current, position)
+	}
+
+	app.assign(name, current, block, position)
+}
+
+func (app *analysis) attachment(attachment attachments.Attachment, current *scope, position location) {
+	attached := attachment.Variable()
+	app.read(attached.Current(), current, position)
+	target := app.application(attachment.Application(), current, position)
+	if target == nil || target.inputs == nil {
+		return
+	}
+
+	if attached.Target() >= *target.inputs {
+		str := fmt.Sprintf("the application (name: %s) reads %d input(s), but a value is attached to the input (index: %d)", attachment.Application(), *target.inputs, attached.Target())
+		app.report(RuleUnreadAttachment, str, attachment.Application(), position)
+	}
+}
+
+func (app *analysis) loop(loop instructions.Loop, current *scope, position location) {
+	app.read(loop.Variable(), current, position)
+
+	// the item and the variables first assigned in the instructions are only referenceable inside the loop:
+	body := createScope(current, false)
+	if loop.HasItem() {
+		item := loop.Item()
+		body.variables[string(item)] = &variable{
+			name:       item,
+			position:   position,
+			isAssigned: true,
+		}
+	}
+
+	if loop.HasInstructions() {
+		app.block(loop.Instructions().List(), body, position.position)
+	}
+
+	app.close(body)
+}
+
+// application marks the application as used, returning it if it is declared
+func (app *analysis) application(name []byte, current *scope, position location) *application {
+	found := current.fetchApplication(string(name))
+	if found == nil {
+		str := fmt.Sprintf("the application (name: %s) is used but never declared", name)
+		app.report(RuleUndefinedApplication, str, name, position)
+		return nil
+	}
+
+	if found.variable != nil {
+		found.variable.isRead = true
+		found.variable.pending = nil
+	}
+
+	return found
+}
+
+func (app *analysis) read(name []byte, current *scope, position location) {
+	found := current.fetchVariable(string(name))
+	if found == nil || !found.isAssigned {
+		str := fmt.Sprintf("the variable (name: %s) is used before being assigned", name)
+		app.report(RuleUndefinedVariable, str, name, position)
+		return
+	}
+
+	found.isRead = true
+	found.pending = nil
+}
+
+func (app *analysis) assign(name []byte, current *scope, block uint, position location) *variable {
+	found := current.fetchVariable(string(name))
+	if found == nil {
+		created := variable{
+			name:       name,
+			position:   position,
+			isAssigned: true,
+			pending: &pending{
+				block:    block,
+				position: position,
+			},
+		}
+
+		current.owner().variables[string(name)] = &created
+		return &created
+	}
+
+	if found.isInput {
+		str := fmt.Sprintf("the input (name: %s) is assigned a new value", name)
+		app.report(RuleOverwrittenInput, str, name, position)
+	} else if found.pending != nil && found.pending.block == block && !isHidden(name) {
+		str := fmt.Sprintf("the variable (name: %s) is assigned again before its value assigned at instruction (%s) is used", name, FormatPosition(found.pending.position.position))
+		app.report(RuleReassignedBeforeUse, str, name, position)
+	}
+
+	found.isAssigned = true
+	found.pending = &pending{
+		block:    block,
+		position: position,
+	}
+
+	return found
+}
+
+func (app *analysis) report(rule string, message string, name []byte, position location) {
+	app.diagnostics = append(app.diagnostics, Diagnostic{
+		Rule:        rule,
+		Message:     message,
+		Name:        name,
+		Position:    position.position,
+		Instruction: position.instruction,
+	})
+}
+
+// sorted returns the diagnostics ordered by position, then by rule and name
+func (app *analysis) sorted() []Diagnostic {
+	out := append([]Diagnostic{}, app.diagnostics...)
+	sort.SliceStable(out, func(i int, j int) bool {
+		first := out[i].Position
+		second := out[j].Position
+		for idx := 0; idx < len(first) && idx < len(second); idx++ {
+			if first[idx] != second[idx] {
+				return first[idx] < second[idx]
+			}
+		}
+
+		if len(first) != len(second) {
+			return len(first) < len(second)
+		}
+
+		if out[i].Rule != out[j].Rule {
+			return out[i].Rule < out[j].Rule
+		}
+
+		return string(out[i].Name) < string(out[j].Name)
+	})
+
+	return out
+}
+
+func countInputs(list []instructions.Instruction) uint {
+	amount := uint(0)
+	for _, oneInstruction := range list {
+		if oneInstruction.IsParameter() && oneInstruction.Parameter().IsInput() {
+			amount++
+		}
+	}
+
+	return amount
+}
+
+// isLowered returns true if the instruction is followed by the other instructions lowered from the same instruction of the script, false otherwise
+func isLowered(instruction instructions.Instruction) bool {
+	if instruction.IsApplication() {
+		return isHidden(instruction.Application().Name())
+	}
+
+	if instruction.IsAttachment() {
+		return isHidden(instruction.Attachment().Application())
+	}
+
+	if instruction.IsAssignment() {
+		return isHidden(instruction.Assignment().Variable())
+	}
+
+	return false
+}
+
+func appendPosition(position []uint, index int) []uint {
+	return append(append([]uint{}, position...), uint(index))
+}
+
+func isHidden(name []byte) bool {
+	return strings.Contains(string(name), hiddenNameCharacter)
+}
@@ -0,0 +1,34 @@
+package linters
+
+type builder struct {
+	inputs map[uint]uint
+}
+
+func createBuilder() Builder {
+	out := builder{
+		inputs: nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *builder) Create() Builder {
+	return createBuilder()
+}
+
+// WithInputs adds the amount of inputs read by the modules, by module index, to the builder
+func (app *builder) WithInputs(inputs map[uint]uint) Builder {
+	app.inputs = inputs
+	return app
+}
+
+// Now builds a new Linter instance
+func (app *builder) Now() (Linter, error) {
+	inputs := map[uint]uint{}
+	for index, amount := range app.inputs {
+		inputs[index] = amount
+	}
+
+	return createDefaultLinter(inputs), nil
+}
@@ -0,0 +1,74 @@
+package linters
+
+import (
+	"errors"
+	"fmt"
+
+	ast_applications "github.com/steve-care-software/ast/applications"
+	"github.com/steve-care-software/ast/domain/grammars"
+	"github.com/steve-care-software/interpreter/domain/instructions"
+	query_applications "github.com/steve-care-software/query/applications"
+	"github.com/steve-care-software/query/domain/queries"
+)
+
+type linter struct {
+	astApplication   ast_applications.Application
+	queryApplication query_applications.Application
+	grammar          grammars.Grammar
+	query            queries.Query
+	inputs           map[uint]uint
+}
+
+func createLinter(
+	astApplication ast_applications.Application,
+	queryApplication query_applications.Application,
+	grammar grammars.Grammar,
+	query queries.Query,
+	inputs map[uint]uint,
+) Linter {
+	out := linter{
+		astApplication:   astApplication,
+		queryApplication: queryApplication,
+		grammar:          grammar,
+		query:            query,
+		inputs:           inputs,
+	}
+
+	return &out
+}
+
+// Lint parses the script and returns the problems found in its instructions
+func (app *linter) Lint(script []byte) ([]Diagnostic, error) {
+	tree, err := app.astApplication.Execute(app.grammar, script)
+	if err != nil {
+		return nil, err
+	}
+
+	if tree.HasRemaining() {
+		str := fmt.Sprintf("the script contains data that could not be lexed: %s", tree.Remaining())
+		return nil, errors.New(str)
+	}
+
+	ins, isValid, _, err := app.queryApplication.Execute(app.query, tree)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isValid {
+		return nil, errors.New("the script could not be parsed into instructions")
+	}
+
+	casted, ok := ins.(instructions.Instructions)
+	if !ok {
+		return nil, errors.New("the query was expected to return instructions")
+	}
+
+	return app.LintInstructions(casted), nil
+}
+
+// LintInstructions returns the problems found in the instructions
+func (app *linter) LintInstructions(instructions instructions.Instructions) []Diagnostic {
+	analysis := createAnalysis(app.inputs)
+	analysis.program(instructions.List(), nil, []uint{})
+	return analysis.sorted()
+}
@@ -0,0 +1,246 @@
+package linters
+
+import (
+	"testing"
+)
+
+func TestLinter_withCleanScript_Success(t *testing.T) {
+	script := `
+		module @list:0;;
+		module @castToBool:11;;
+
+		-> $first;;
+		-> $second;;
+		<- $output;;
+
+		$isValid = @castToBool($first);;
+		if $isValid {
+			$output = @list($first, $second);;
+		} else {
+			$output = @list($second);;
+		};;
+	`
+
+	diagnostics := lintScript(t, script)
+	if len(diagnostics) != 0 {
+		t.Errorf("no diagnostic was expected, %d returned: %v", len(diagnostics), diagnostics)
+		return
+	}
+}
+
+func TestLinter_Success(t *testing.T) {
+	cases := []struct {
+		name   string
+		script string
+		rule   string
+		target string
+	}{
+		{
+			name: "undefined variable",
+			script: `
+				<- $output;;
+				$output = $missing;;
+			`,
+			rule:   RuleUndefinedVariable,
+			target: "missing",
+		},
+		{
+			name: "undefined application",
+			script: `
+				-> $input;;
+				attach $input:0 $missing;;
+			`,
+			rule:   RuleUndefinedApplication,
+			target: "missing",
+		},
+		{
+			name: "undefined module",
+			script: `
+				-> $input;;
+				<- $output;;
+				$output = @castToBool($input);;
+			`,
+			rule:   RuleUndefinedModule,
+			target: "castToBool",
+		},
+		{
+			name: "unused variable",
+			script: `
+				<- $output;;
+				$unused = true;;
+				$output = false;;
+			`,
+			rule:   RuleUnusedVariable,
+			target: "unused",
+		},
+		{
+			name: "unused input",
+			script: `
+				-> $input;;
+				<- $output;;
+				$output = true;;
+			`,
+			rule:   RuleUnusedInput,
+			target: "input",
+		},
+		{
+			name: "unassigned output",
+			script: `
+				-> $input;;
+				<- $output;;
+				<- $other;;
+				$output = $input;;
+			`,
+			rule:   RuleUnassignedOutput,
+			target: "other",
+		},
+		{
+			name: "unread attachment",
+			script: `
+				module @castToBool:11;;
+				-> $input;;
+				<- $output;;
+				$output = @castToBool($input, $input);;
+			`,
+			rule:   RuleUnreadAttachment,
+			target: "castToBool#",
+		},
+		{
+			name: "reassigned before use",
+			script: `
+				<- $output;;
+				$value = true;;
+				$value = false;;
+				$output = $value;;
+			`,
+			rule:   RuleReassignedBeforeUse,
+			target: "value",
+		},
+		{
+			name: "overwritten input",
+			script: `
+				-> $input;;
+				<- $output;;
+				$input = true;;
+				$output = $input;;
+			`,
+			rule:   RuleOverwrittenInput,
+			target: "input",
+		},
+	}
+
+	for _, oneCase := range cases {
+		diagnostics := lintScript(t, oneCase.script)
+		if len(diagnostics) != 1 {
+			t.Errorf("%s: 1 diagnostic was expected, %d returned: %v", oneCase.name, len(diagnostics), diagnostics)
+			continue
+		}
+
+		if diagnostics[0].Rule != oneCase.rule {
+			t.Errorf("%s: the rule was expected to be '%s', '%s' returned", oneCase.name, oneCase.rule, diagnostics[0].Rule)
+			continue
+		}
+
+		name := string(diagnostics[0].Name)
+		if len(name) < len(oneCase.target) || name[:len(oneCase.target)] != oneCase.target {
+			t.Errorf("%s: the name was expected to start with '%s', '%s' returned", oneCase.name, oneCase.target, name)
+			continue
+		}
+	}
+}
+
+func TestLinter_withScopes_Success(t *testing.T) {
+	script := `
+		module @list:0;;
+
+		-> $values;;
+		<- $output;;
+
+		// the item is only referenceable inside the loop:
+		$output = for $item in $values {
+			$copy = $item;;
+		};;
+
+		$other = $item;;
+		$output = @list($other);;
+	`
+
+	diagnostics := lintScript(t, script)
+	found := false
+	for _, oneDiagnostic := range diagnostics {
+		if oneDiagnostic.Rule == RuleUndefinedVariable && string(oneDiagnostic.Name) == "item" {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Errorf("the loop item was expected to be undefined outside of the loop, returned: %v", diagnostics)
+		return
+	}
+}
+
+func TestLinter_withLoweredInstructions_returnsScriptInstruction(t *testing.T) {
+	script := `
+		module @castToBool:11;;
+		-> $input;;
+		<- $output;;
+		$first = @castToBool(@castToBool($input));;
+		if $first {
+			$unused = true;;
+		};;
+		$output = $first;;
+	`
+
+	diagnostics := lintScript(t, script)
+	if len(diagnostics) != 1 {
+		t.Errorf("1 diagnostic was expected, %d returned: %v", len(diagnostics), diagnostics)
+		return
+	}
+
+	// the call is lowered into many instructions, but the branch's instruction is the fifth one of the script:
+	if diagnostics[0].Instruction != 5 {
+		t.Errorf("the instruction was expected to be %d, %d returned", 5, diagnostics[0].Instruction)
+		return
+	}
+}
+
+func TestLinter_withUnlexableScript_returnsError(t *testing.T) {
+	linter, err := NewBuilder().Create().Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = linter.Lint([]byte("<- $output;; ~~~"))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestFormatPosition_Success(t *testing.T) {
+	formatted := FormatPosition([]uint{3, 0, 12})
+	if formatted != "3.0.12" {
+		t.Errorf("the position was expected to be '%s', '%s' returned", "3.0.12", formatted)
+		return
+	}
+}
+
+func lintScript(t *testing.T, script string) []Diagnostic {
+	linter, err := NewBuilder().Create().WithInputs(map[uint]uint{
+		11: 1,
+	}).Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return nil
+	}
+
+	diagnostics, err := linter.Lint([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return nil
+	}
+
+	return diagnostics
+}
@@ -0,0 +1,98 @@
+package linters
+
+// scope represents the modules, applications and variables declared in a program or in one of its branches
+type scope struct {
+	parent        *scope
+	isTransparent bool
+	modules       map[string]uint
+	applications  map[string]*application
+	variables     map[string]*variable
+}
+
+// application represents a declared application, along with the amount of inputs it reads if known
+type application struct {
+	inputs   *uint
+	variable *variable
+}
+
+// variable represents a declared variable
+type variable struct {
+	name       []byte
+	position   location
+	isInput    bool
+	isOutput   bool
+	isAssigned bool
+	isRead     bool
+	pending    *pending
+}
+
+// pending represents an assignment whose value has not been used yet
+type pending struct {
+	block    uint
+	position location
+}
+
+// location represents the position of a lowered instruction, along with the index of the script's instruction it was lowered from
+type location struct {
+	position    []uint
+	instruction uint
+}
+
+// createScope creates a scope, whose first assigned variables belong to its parent when it is transparent
+func createScope(
+	parent *scope,
+	isTransparent bool,
+) *scope {
+	out := scope{
+		parent:        parent,
+		isTransparent: isTransparent,
+		modules:       map[string]uint{},
+		applications:  map[string]*application{},
+		variables:     map[string]*variable{},
+	}
+
+	return &out
+}
+
+// owner returns the nearest scope that is not transparent
+func (app *scope) owner() *scope {
+	current := app
+	for current.isTransparent && current.parent != nil {
+		current = current.parent
+	}
+
+	return current
+}
+
+// fetchModule returns the index of the module declared in the scope or its nearest parent
+func (app *scope) fetchModule(name string) (uint, bool) {
+	for current := app; current != nil; current = current.parent {
+		if index, ok := current.modules[name]; ok {
+			return index, true
+		}
+	}
+
+	return 0, false
+}
+
+// fetchApplication returns the application declared in the scope or its nearest parent
+func (app *scope) fetchApplication(name string) *application {
+	for current := app; current != nil; current = current.parent {
+		if ins, ok := current.applications[name]; ok {
+			return ins
+		}
+	}
+
+	return nil
+}
+
+// fetchVariable returns the variable declared in the scope or its nearest parent
+func (app *scope) fetchVariable(name string) *variable {
+	for current := app; current != nil; current = current.parent {
+		if ins, ok := current.variables[name]; ok {
+			return ins
+		}
+	}
+
+	return nil
+}
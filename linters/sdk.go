@@ -0,0 +1,92 @@
+package linters
+
+import (
+	"fmt"
+	"strings"
+
+	ast_applications "github.com/steve-care-software/ast/applications"
+	"github.com/steve-care-software/interpreter/domain/instructions"
+	query_applications "github.com/steve-care-software/query/applications"
+	rodan_grammars "github.com/steve-care-software/rodan/grammars"
+	"github.com/steve-care-software/rodan/queries"
+)
+
+const (
+	// RuleUndefinedVariable represents the rule reporting the variables used before being assigned
+	RuleUndefinedVariable = "undefined-variable"
+
+	// RuleUndefinedApplication represents the rule reporting the applications used without being declared
+	RuleUndefinedApplication = "undefined-application"
+
+	// RuleUndefinedModule represents the rule reporting the modules used without being declared
+	RuleUndefinedModule = "undefined-module"
+
+	// RuleUnusedVariable represents the rule reporting the variables assigned but never used
+	RuleUnusedVariable = "unused-variable"
+
+	// RuleUnusedInput represents the rule reporting the inputs never used
+	RuleUnusedInput = "unused-input"
+
+	// RuleUnassignedOutput represents the rule reporting the outputs never assigned
+	RuleUnassignedOutput = "unassigned-output"
+
+	// RuleUnreadAttachment represents the rule reporting the attachments to an input the application never reads
+	RuleUnreadAttachment = "unread-attachment"
+
+	// RuleReassignedBeforeUse represents the rule reporting the variables assigned again before their value is used
+	RuleReassignedBeforeUse = "reassigned-before-use"
+
+	// RuleOverwrittenInput represents the rule reporting the inputs assigned a new value
+	RuleOverwrittenInput = "overwritten-input"
+)
+
+// hiddenNameCharacter is contained in the names the query gives to the applications and variables it adds, which are never reported
+const hiddenNameCharacter = "#"
+
+// NewBuilder creates a new linter builder
+func NewBuilder() Builder {
+	return createBuilder()
+}
+
+// FormatPosition formats a position as the dot separated indexes of its instructions
+func FormatPosition(position []uint) string {
+	indexes := []string{}
+	for _, oneIndex := range position {
+		indexes = append(indexes, fmt.Sprintf("%d", oneIndex))
+	}
+
+	return strings.Join(indexes, ".")
+}
+
+// Builder represents a linter builder
+type Builder interface {
+	Create() Builder
+	WithInputs(inputs map[uint]uint) Builder
+	Now() (Linter, error)
+}
+
+// Linter represents a static analyzer of scripts
+type Linter interface {
+	Lint(script []byte) ([]Diagnostic, error)
+	LintInstructions(instructions instructions.Instructions) []Diagnostic
+}
+
+// Diagnostic represents a problem found in a script, its position containing the index of the instruction inside each nested instructions, from the outermost,
+// and its instruction the index of the script's instruction it was found in, counting the instructions in the order they are written, nested ones included
+type Diagnostic struct {
+	Rule        string
+	Message     string
+	Name        []byte
+	Position    []uint
+	Instruction uint
+}
+
+func createDefaultLinter(inputs map[uint]uint) Linter {
+	return createLinter(
+		ast_applications.NewApplication(),
+		query_applications.NewApplication(),
+		rodan_grammars.NewInstructionsGrammar(),
+		queries.NewQuery(),
+		inputs,
+	)
+}
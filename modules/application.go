@@ -0,0 +1,110 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/steve-care-software/ast/domain/trees"
+	interpreter_applications "github.com/steve-care-software/interpreter/applications"
+	"github.com/steve-care-software/interpreter/domain/programs"
+	"github.com/steve-care-software/rodan/capabilities"
+	"github.com/steve-care-software/rodan/limits"
+	"github.com/steve-care-software/rodan/traces"
+	vm_applications "github.com/steve-care-software/vm/applications"
+)
+
+type application struct {
+	environment  *environment
+	capabilities capabilities.Capabilities
+	tracer       traces.Tracer
+}
+
+func createApplication(
+	environment *environment,
+	capabilities capabilities.Capabilities,
+	tracer traces.Tracer,
+) vm_applications.Application {
+	out := application{
+		environment:  environment,
+		capabilities: capabilities,
+		tracer:       tracer,
+	}
+
+	return &out
+}
+
+// Lex lexes values into an AST
+func (app *application) Lex(values []byte) (trees.Tree, error) {
+	return app.environment.application(app.capabilities).Lex(values)
+}
+
+// Parse parses an AST into a program, granting the modules of the capabilities declared in its header, if any, that the application grants
+func (app *application) Parse(tree trees.Tree) (programs.Program, []byte, error) {
+	return app.ParseContext(context.Background(), tree)
+}
+
+// ParseContext parses an AST into a program like Parse, reading the scripts it imports within the sandbox and the meter of the context
+func (app *application) ParseContext(ctx context.Context, tree trees.Tree) (programs.Program, []byte, error) {
+	capabilitiesIns, err := app.environment.restrict(app.capabilities, tree.Bytes(true))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// a parse outside of an interpretation is metered on its own:
+	if _, ok := ctx.Value(meterKey{}).(*meter); !ok {
+		ctx = withMeter(ctx, app.environment.meter.start())
+	}
+
+	return app.environment.application(capabilitiesIns).ParseContext(ctx, tree)
+}
+
+// Interpret interprets a program with input and returns its output, within the limits of the application
+func (app *application) Interpret(input []interface{}, program programs.Program) ([]interface{}, error) {
+	return app.InterpretContext(context.Background(), input, program)
+}
+
+// InterpretContext interprets a program with input and returns its output, within the limits of the application, stopping as soon as the context is done
+func (app *application) InterpretContext(ctx context.Context, input []interface{}, program programs.Program) ([]interface{}, error) {
+	// every interpretation has its own meter, so that concurrent interpretations do not share their counters:
+	meterIns := app.environment.meter.start()
+	ctx = withMeter(ctx, meterIns)
+	ctx = interpreter_applications.WithHook(ctx, app.hook(meterIns))
+	ctx = withQueryContexts(ctx)
+
+	// an exceeded limit can never be caught by a try:
+	ctx = interpreter_applications.WithUncaught(ctx, limits.Errors()...)
+	if limitsIns := app.environment.meter.limits; limitsIns != nil && limitsIns.HasCallDepth() {
+		ctx = interpreter_applications.WithMaxCallDepth(ctx, *limitsIns.CallDepth())
+	}
+
+	vmApplication := app.environment.application(app.capabilities)
+	pDeadline := meterIns.pDeadline
+	if pDeadline == nil {
+		return vmApplication.InterpretContext(ctx, input, program)
+	}
+
+	deadlineCtx, cancel := context.WithDeadline(ctx, *pDeadline)
+	defer cancel()
+
+	output, err := vmApplication.InterpretContext(deadlineCtx, input, program)
+	if err != nil && ctx.Err() == nil && errors.Is(err, context.DeadlineExceeded) {
+		return nil, fmt.Errorf("%w: %s", limits.ErrDeadlineExceeded, err.Error())
+	}
+
+	return output, err
+}
+
+// hook returns the func called after every executed instruction, which traces it and meters it
+func (app *application) hook(meterIns *meter) interpreter_applications.HookFn {
+	return func(ctx context.Context, event interpreter_applications.Event) error {
+		if app.tracer != nil {
+			err := app.tracer.Trace(ctx, event)
+			if err != nil {
+				return err
+			}
+		}
+
+		return meterIns.instruction()
+	}
+}
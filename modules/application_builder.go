@@ -0,0 +1,98 @@
+package modules
+
+import (
+	"crypto/aes"
+	"errors"
+	"fmt"
+
+	"github.com/steve-care-software/rodan/capabilities"
+	"github.com/steve-care-software/rodan/limits"
+	"github.com/steve-care-software/rodan/traces"
+	vm_applications "github.com/steve-care-software/vm/applications"
+)
+
+type applicationBuilder struct {
+	basePath      string
+	pChunkSize    *uint
+	limits        limits.Limits
+	capabilities  capabilities.Capabilities
+	tracer        traces.Tracer
+	encryptionKey []byte
+}
+
+func createApplicationBuilder() ApplicationBuilder {
+	out := applicationBuilder{
+		basePath:      "",
+		pChunkSize:    nil,
+		limits:        nil,
+		capabilities:  nil,
+		tracer:        nil,
+		encryptionKey: nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *applicationBuilder) Create() ApplicationBuilder {
+	return createApplicationBuilder()
+}
+
+// WithBasePath adds a base path to the builder
+func (app *applicationBuilder) WithBasePath(basePath string) ApplicationBuilder {
+	app.basePath = basePath
+	return app
+}
+
+// WithChunkSize adds a chunk size to the builder
+func (app *applicationBuilder) WithChunkSize(chunkSize uint) ApplicationBuilder {
+	app.pChunkSize = &chunkSize
+	return app
+}
+
+// WithLimits adds limits to the builder
+func (app *applicationBuilder) WithLimits(limits limits.Limits) ApplicationBuilder {
+	app.limits = limits
+	return app
+}
+
+// WithCapabilities adds capabilities to the builder
+func (app *applicationBuilder) WithCapabilities(capabilities capabilities.Capabilities) ApplicationBuilder {
+	app.capabilities = capabilities
+	return app
+}
+
+// WithTracer adds a tracer to the builder
+func (app *applicationBuilder) WithTracer(tracer traces.Tracer) ApplicationBuilder {
+	app.tracer = tracer
+	return app
+}
+
+// WithEncryptionKey adds an encryption key to the builder, encrypting the files at rest
+func (app *applicationBuilder) WithEncryptionKey(encryptionKey []byte) ApplicationBuilder {
+	app.encryptionKey = encryptionKey
+	return app
+}
+
+// Now builds a new Application instance
+func (app *applicationBuilder) Now() (vm_applications.Application, error) {
+	if app.basePath == "" {
+		return nil, errors.New("the basePath is mandatory in order to build an Application instance")
+	}
+
+	if app.pChunkSize == nil {
+		return nil, errors.New("the chunkSize is mandatory in order to build an Application instance")
+	}
+
+	if app.encryptionKey != nil {
+		_, err := aes.NewCipher(app.encryptionKey)
+		if err != nil {
+			str := fmt.Sprintf("the encryptionKey is invalid: %s", err.Error())
+			return nil, errors.New(str)
+		}
+	}
+
+	meter := createMeter(app.limits)
+	environment := newEnvironment(app.basePath, *app.pChunkSize, meter, app.encryptionKey)
+	return createApplication(environment, app.capabilities, app.tracer), nil
+}
@@ -0,0 +1,155 @@
+package modules
+
+import (
+	"errors"
+	"math"
+	"math/big"
+
+	"github.com/steve-care-software/interpreter/domain/programs/modules"
+)
+
+type arithmetic struct {
+}
+
+func createArithmetic() *arithmetic {
+	out := arithmetic{}
+	return &out
+}
+
+// Execute executes the application
+func (app *arithmetic) Execute() map[uint]modules.ExecuteFn {
+	add := app.operation(
+		func(first *big.Int, second *big.Int) (*big.Int, error) {
+			return new(big.Int).Add(first, second), nil
+		},
+		func(first float64, second float64) (float64, error) {
+			return first + second, nil
+		},
+	)
+
+	sub := app.operation(
+		func(first *big.Int, second *big.Int) (*big.Int, error) {
+			return new(big.Int).Sub(first, second), nil
+		},
+		func(first float64, second float64) (float64, error) {
+			return first - second, nil
+		},
+	)
+
+	mul := app.operation(
+		func(first *big.Int, second *big.Int) (*big.Int, error) {
+			return new(big.Int).Mul(first, second), nil
+		},
+		func(first float64, second float64) (float64, error) {
+			return first * second, nil
+		},
+	)
+
+	// the integer divisions truncate toward zero, as in Go:
+	div := app.operation(
+		func(first *big.Int, second *big.Int) (*big.Int, error) {
+			if second.Sign() == 0 {
+				return nil, errors.New(divisionByZeroError)
+			}
+
+			return new(big.Int).Quo(first, second), nil
+		},
+		func(first float64, second float64) (float64, error) {
+			if second == 0 {
+				return 0, errors.New(divisionByZeroError)
+			}
+
+			return first / second, nil
+		},
+	)
+
+	// the remainder has the sign of the dividend, as in Go:
+	mod := app.operation(
+		func(first *big.Int, second *big.Int) (*big.Int, error) {
+			if second.Sign() == 0 {
+				return nil, errors.New(divisionByZeroError)
+			}
+
+			return new(big.Int).Rem(first, second), nil
+		},
+		func(first float64, second float64) (float64, error) {
+			if second == 0 {
+				return 0, errors.New(divisionByZeroError)
+			}
+
+			return math.Mod(first, second), nil
+		},
+	)
+
+	return map[uint]modules.ExecuteFn{
+		ModuleAdd: add,
+		ModuleSub: sub,
+		ModuleMul: mul,
+		ModuleDiv: div,
+		ModuleMod: mod,
+		ModuleMin: app.extremum(-1),
+		ModuleMax: app.extremum(1),
+	}
+}
+
+// operation returns a module computing its two inputs, exactly on the integers, then returning the result in the type of their promotion
+func (app *arithmetic) operation(
+	integerFn func(first *big.Int, second *big.Int) (*big.Int, error),
+	floatFn func(first float64, second float64) (float64, error),
+) modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		first, err := inputNumber(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		second, err := inputNumber(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		kind := promote(first, second)
+		if kind == promotionFloat {
+			result, err := floatFn(first.toFloat64(), second.toFloat64())
+			if err != nil {
+				return nil, err
+			}
+
+			return fromFloat(result)
+		}
+
+		result, err := integerFn(first.integer, second.integer)
+		if err != nil {
+			return nil, err
+		}
+
+		return fromInteger(result, kind)
+	}
+}
+
+// extremum returns a module returning the lowest of its two inputs when the sign is -1, or the greatest when it is 1
+func (app *arithmetic) extremum(sign int) modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		first, err := inputNumber(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		second, err := inputNumber(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		comparison, err := compareNumbers(first, second)
+		if err != nil {
+			return nil, err
+		}
+
+		kind := promote(first, second)
+		if comparison == sign || comparison == 0 {
+			return fromNumber(first, kind)
+		}
+
+		return fromNumber(second, kind)
+	}
+}
@@ -0,0 +1,131 @@
+package modules
+
+import (
+	"math"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/steve-care-software/ast/domain/grammars/cardinalities"
+)
+
+func TestArithmetic_Success(t *testing.T) {
+	testCases := []struct {
+		name     string
+		module   uint
+		first    interface{}
+		second   interface{}
+		expected interface{}
+		isError  bool
+	}{
+		{name: "add, unsigned", module: ModuleAdd, first: uint(2), second: uint8(3), expected: uint(5)},
+		{name: "add, signed", module: ModuleAdd, first: -2, second: uint(3), expected: 1},
+		{name: "add, float", module: ModuleAdd, first: uint(2), second: 0.5, expected: 2.5},
+		{name: "add, float32", module: ModuleAdd, first: float32(0.5), second: float32(0.25), expected: 0.75},
+		{name: "add, unsigned overflow", module: ModuleAdd, first: uint(math.MaxUint64), second: uint(1), isError: true},
+		{name: "add, signed overflow", module: ModuleAdd, first: math.MaxInt64, second: 1, isError: true},
+		{name: "add, infinite float", module: ModuleAdd, first: math.MaxFloat64, second: math.MaxFloat64, isError: true},
+		{name: "add, not a number", module: ModuleAdd, first: []byte("1"), second: 1, isError: true},
+		{name: "sub, unsigned", module: ModuleSub, first: uint(5), second: uint(3), expected: uint(2)},
+		{name: "sub, unsigned below zero", module: ModuleSub, first: uint(3), second: uint(5), isError: true},
+		{name: "sub, signed", module: ModuleSub, first: 3, second: uint(5), expected: -2},
+		{name: "sub, signed underflow", module: ModuleSub, first: math.MinInt64, second: 1, isError: true},
+		{name: "mul, unsigned", module: ModuleMul, first: uint(6), second: uint(7), expected: uint(42)},
+		{name: "mul, signed", module: ModuleMul, first: int8(-6), second: int8(7), expected: -42},
+		{name: "mul, overflow", module: ModuleMul, first: uint(math.MaxUint32 + 1), second: uint(math.MaxUint32 + 1), isError: true},
+		{name: "div, unsigned", module: ModuleDiv, first: uint(7), second: uint(2), expected: uint(3)},
+		{name: "div, signed truncated toward zero", module: ModuleDiv, first: -7, second: 2, expected: -3},
+		{name: "div, signed overflow", module: ModuleDiv, first: math.MinInt64, second: -1, isError: true},
+		{name: "div, float", module: ModuleDiv, first: 7, second: 2.0, expected: 3.5},
+		{name: "div, by zero", module: ModuleDiv, first: uint(7), second: uint(0), isError: true},
+		{name: "div, float by zero", module: ModuleDiv, first: 7.0, second: 0.0, isError: true},
+		{name: "mod, unsigned", module: ModuleMod, first: uint(7), second: uint(3), expected: uint(1)},
+		{name: "mod, signed dividend", module: ModuleMod, first: -7, second: 3, expected: -1},
+		{name: "mod, float", module: ModuleMod, first: 7.5, second: 2, expected: 1.5},
+		{name: "mod, by zero", module: ModuleMod, first: 7, second: 0, isError: true},
+		{name: "min, unsigned", module: ModuleMin, first: uint(7), second: uint(3), expected: uint(3)},
+		{name: "min, signed", module: ModuleMin, first: uint(7), second: -3, expected: -3},
+		{name: "min, float", module: ModuleMin, first: uint(1), second: 1.5, expected: 1.0},
+		{name: "min, NaN", module: ModuleMin, first: math.NaN(), second: 1, isError: true},
+		{name: "max, unsigned", module: ModuleMax, first: uint(7), second: uint(3), expected: uint(7)},
+		{name: "max, signed", module: ModuleMax, first: uint(7), second: -3, expected: 7},
+		{name: "max, negative", module: ModuleMax, first: int8(-7), second: int8(-3), expected: -3},
+	}
+
+	fns := createArithmetic().Execute()
+	for _, oneTestCase := range testCases {
+		output, err := fns[oneTestCase.module](map[uint]interface{}{
+			0: oneTestCase.first,
+			1: oneTestCase.second,
+		})
+
+		if oneTestCase.isError {
+			if err == nil {
+				t.Errorf("%s: the error was expected to be valid, nil returned", oneTestCase.name)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: the error was expected to be nil, error returned: %s", oneTestCase.name, err.Error())
+			continue
+		}
+
+		if !reflect.DeepEqual(output, oneTestCase.expected) {
+			t.Errorf("%s: the output was expected to be %v (%T), %v (%T) returned", oneTestCase.name, oneTestCase.expected, oneTestCase.expected, output, output)
+			continue
+		}
+	}
+}
+
+func TestArithmetic_withCardinality_Success(t *testing.T) {
+	script := `
+		module @cardinality:15;;
+		module @add:71;;
+		module @mul:73;;
+
+		-> $min;;
+		<- $cardinality;;
+
+		$two = 2;;
+		$cardinality = @cardinality($min, @add($min, @mul($min, $two)));;
+	`
+
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	output, err := application.Interpret([]interface{}{
+		uint(2),
+	}, program)
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	cardinality := output[0].(cardinalities.Cardinality)
+	if cardinality.Min() != 2 || !cardinality.HasMax() || *cardinality.Max() != 6 {
+		t.Errorf("the cardinality was expected to be [2,6]")
+		return
+	}
+}
@@ -0,0 +1,91 @@
+package modules
+
+import (
+	"os"
+	"testing"
+)
+
+func TestCall_Success(t *testing.T) {
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		module @list:0;;
+		module @castToBool:11;;
+
+		-> $first;;
+		-> $second;;
+		-> $third;;
+		<- $pair;;
+		<- $single;;
+		<- $bools;;
+
+		$pair = @list($first, $second);;
+
+		// the attachments of the previous call do not leak into this one:
+		$single = @list($third);;
+
+		$bools = @list(@castToBool($first), @castToBool($third));;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	output, err := application.Interpret([]interface{}{
+		"true",
+		"false",
+		"false",
+	}, program)
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(output) != 3 {
+		t.Errorf("%d output was expected, %d returned", 3, len(output))
+		return
+	}
+
+	pair := output[0].([]interface{})
+	if len(pair) != 2 {
+		t.Errorf("the pair was expected to contain %d values, %d returned", 2, len(pair))
+		return
+	}
+
+	single := output[1].([]interface{})
+	if len(single) != 1 {
+		t.Errorf("the single was expected to contain %d value, %d returned", 1, len(single))
+		return
+	}
+
+	bools := output[2].([]interface{})
+	expected := []bool{true, false}
+	if len(bools) != len(expected) {
+		t.Errorf("%d bools were expected, %d returned", len(expected), len(bools))
+		return
+	}
+
+	for idx, oneBool := range bools {
+		if oneBool.(bool) != expected[idx] {
+			t.Errorf("the bool (index: %d) was expected to be %t, %t returned", idx, expected[idx], oneBool)
+			return
+		}
+	}
+}
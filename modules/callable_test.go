@@ -0,0 +1,220 @@
+package modules
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	interpreter_applications "github.com/steve-care-software/interpreter/applications"
+	"github.com/steve-care-software/rodan/limits"
+)
+
+func TestCallable_lexicalScope_Success(t *testing.T) {
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		module @castToBool:11;;
+		@castToBool $toBool;;
+
+		-> $first;;
+		<- $output;;
+		<- $counter;;
+
+		$counter = outer;;
+		$greeting = hello;;
+		$fn = {
+			-> $value;;
+			<- $result;;
+
+			attach $value:0 $toBool;;
+			$isTrue = execute $toBool;;
+
+			$counter = inner;;
+			if $isTrue {
+				$result = $greeting;;
+			} else {
+				$result = $first;;
+			};;
+		};;
+
+		attach $first:0 $fn;;
+		$output = execute $fn;;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expectations := map[string]string{
+		"true":  " hello",
+		"false": "false",
+	}
+
+	for input, expected := range expectations {
+		output, err := application.Interpret([]interface{}{input}, program)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(output) != 2 {
+			t.Errorf("%d output was expected, %d returned", 2, len(output))
+			return
+		}
+
+		if string(output[0].([]byte)) != " outer" {
+			t.Errorf("the counter was expected to be '%s', '%s' returned", " outer", output[0])
+			return
+		}
+
+		result := ""
+		switch casted := output[1].(type) {
+		case []byte:
+			result = string(casted)
+		case string:
+			result = casted
+		}
+
+		if result != expected {
+			t.Errorf("the output was expected to be '%s', '%s' returned (input: %s)", expected, output[1], input)
+			return
+		}
+	}
+}
+
+func TestCallable_recursion_Success(t *testing.T) {
+	limitsIns, err := limits.NewBuilder().Create().WithCallDepth(8).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		WithLimits(limitsIns).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		-> $start;;
+		-> $stop;;
+		<- $output;;
+
+		$fn = {
+			-> $flag;;
+			<- $result;;
+
+			if $flag {
+				attach $stop:0 $fn;;
+				$result = execute $fn;;
+			} else {
+				$result = done;;
+			};;
+		};;
+
+		attach $start:0 $fn;;
+		$output = execute $fn;;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	output, err := application.Interpret([]interface{}{true, false}, program)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if string(output[0].([]byte)) != " done" {
+		t.Errorf("the output was expected to be '%s', '%s' returned", " done", output[0])
+		return
+	}
+
+	_, err = application.Interpret([]interface{}{true, true}, program)
+	if !errors.Is(err, interpreter_applications.ErrCallDepthExceeded) {
+		t.Errorf("the error was expected to be ErrCallDepthExceeded, returned: %v", err)
+		return
+	}
+}
+
+func TestCallable_missingInput_returnsError(t *testing.T) {
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		-> $value;;
+		<- $output;;
+
+		$fn = {
+			-> $first;;
+			-> $second;;
+			<- $result;;
+
+			$result = $second;;
+		};;
+
+		attach $value:0 $fn;;
+		$output = execute $fn;;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = application.Interpret([]interface{}{"value"}, program)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	if !strings.Contains(err.Error(), "(name: second, index: 1)") {
+		t.Errorf("the error was expected to name the missing input, returned: %s", err.Error())
+		return
+	}
+}
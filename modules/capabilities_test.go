@@ -0,0 +1,215 @@
+package modules
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	interpreter_applications "github.com/steve-care-software/interpreter/applications"
+	"github.com/steve-care-software/interpreter/domain/instructions"
+	instructions_applications "github.com/steve-care-software/interpreter/domain/instructions/applications"
+	instructions_modules "github.com/steve-care-software/interpreter/domain/instructions/modules"
+	"github.com/steve-care-software/rodan/capabilities"
+)
+
+func TestCapabilities_deniedModule_failsAtCompile(t *testing.T) {
+	capabilitiesIns, err := capabilities.NewAdapter().ToCapabilities([]byte("file.read"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	environment := newEnvironment(os.TempDir(), 1024, createMeter(nil), nil)
+	modulesIns, err := environment.fetchModulesFn(capabilitiesIns)()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	interpreterApp := interpreter_applications.NewApplication(func(name []byte) string {
+		return string(name)
+	})
+
+	compile := func(index uint) error {
+		module, _ := instructions_modules.NewBuilder().Create().WithIndex(index).WithName([]byte("myModule")).Now()
+		application, _ := instructions_applications.NewBuilder().Create().WithModule([]byte("myModule")).WithName([]byte("myApp")).Now()
+		moduleInstruction, _ := instructions.NewInstructionBuilder().Create().WithModule(module).Now()
+		applicationInstruction, _ := instructions.NewInstructionBuilder().Create().WithApplication(application).Now()
+		executionInstruction, _ := instructions.NewInstructionBuilder().Create().WithExecution([]byte("myApp")).Now()
+		instructionsIns, _ := instructions.NewBuilder().Create().WithList([]instructions.Instruction{
+			moduleInstruction,
+			applicationInstruction,
+			executionInstruction,
+		}).Now()
+
+		_, err := interpreterApp.Compile(modulesIns, instructionsIns)
+		return err
+	}
+
+	err = compile(ModuleFileRead)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = compile(ModuleFileWrite)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestCapabilities_fileRead_underSubPath(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	err = os.Mkdir(filepath.Join(basePath, "data"), 0755)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	for _, oneName := range []string{"data/in.txt", "out.txt"} {
+		err = ioutil.WriteFile(filepath.Join(basePath, oneName), []byte("0123456789"), 0644)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+	}
+
+	capabilitiesIns, _ := capabilities.NewAdapter().ToCapabilities([]byte("file.read:data"))
+	fns := createFile(basePath, 1024, createMeter(nil), capabilitiesIns, nil).Execute()
+	pConn, err := fns[ModuleFileOpen](context.Background(), map[uint]interface{}{
+		0: []byte("data/in.txt"),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer fns[ModuleFileClose](context.Background(), map[uint]interface{}{
+		0: pConn,
+	})
+
+	_, err = fns[ModuleFileRead](context.Background(), map[uint]interface{}{
+		0: pConn,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = fns[ModuleFileWrite](context.Background(), map[uint]interface{}{
+		0: pConn,
+		1: []byte("abc"),
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	_, err = fns[ModuleFileOpen](context.Background(), map[uint]interface{}{
+		0: []byte("out.txt"),
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestCapabilities_nestedScript_withManifest(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	err = os.Mkdir(filepath.Join(basePath, "data"), 0755)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = ioutil.WriteFile(filepath.Join(basePath, "data", "in.txt"), []byte("0123456789"), 0644)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(basePath).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// the nested script is sandboxed in the data directory, read-only, and granted the provided manifest:
+	script := `
+		module @list:0;;
+		module @lexParseThenInterpret:34;;
+
+		-> $script;;
+		-> $manifest;;
+		<- $output;;
+
+		$input = @list();;
+		$subDirectory = "data";;
+		$isReadOnly = true;;
+		$output = @lexParseThenInterpret($script, $input, $subDirectory, $isReadOnly, $manifest);;
+	`
+
+	nested := []byte(`
+		module @fileOpen:2;;
+		module @fileRead:7;;
+
+		<- $data;;
+
+		$path = "in.txt";;
+		$conn = @fileOpen($path);;
+		$data = @fileRead($conn);;
+	`)
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	output, err := application.Interpret([]interface{}{nested, []byte("file.read")}, program)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	data := output[0].([]interface{})[0].([]byte)
+	if string(data) != "0123456789" {
+		t.Errorf("the data was expected to be '%s', '%s' returned", "0123456789", data)
+		return
+	}
+
+	_, err = application.Interpret([]interface{}{nested, []byte("list")}, program)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
@@ -3,12 +3,18 @@ package modules
 import (
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 
 	"github.com/steve-care-software/interpreter/domain/programs/modules"
 )
 
+const hexadecimalPrefix = "0x"
+const binaryPrefix = "0b"
+const negativeSign = "-"
+const positiveSign = "+"
+
 type cast struct {
 }
 
@@ -17,6 +23,12 @@ func createCast() *cast {
 	return &out
 }
 
+// integer represents an integer whose magnitude fits in 64 bits, so that every signed and unsigned integer can be represented
+type integer struct {
+	isNegative bool
+	magnitude  uint64
+}
+
 // Execute executes the application
 func (app *cast) Execute() map[uint]modules.ExecuteFn {
 	return app.castTo()
@@ -28,142 +40,475 @@ func (app *cast) castTo() map[uint]modules.ExecuteFn {
 	toBool := app.castToBool()
 	toFloat32 := app.castToFloat32()
 	toFloat64 := app.castToFloat64()
+	toMap := app.castToMap()
+	toList := app.castToList()
+	toBytes := app.castToBytes()
+	toString := app.castToString()
+	toInt8 := app.castToSignedInteger(8)
+	toInt16 := app.castToSignedInteger(16)
+	toInt32 := app.castToSignedInteger(32)
+	toInt64 := app.castToSignedInteger(64)
+	toUint8 := app.castToUnsignedInteger(8)
+	toUint16 := app.castToUnsignedInteger(16)
+	toUint32 := app.castToUnsignedInteger(32)
+	toUint64 := app.castToUnsignedInteger(64)
 	return map[uint]modules.ExecuteFn{
 		ModuleCastToInt:     toInt,
 		ModuleCastToUint:    toUint,
 		ModuleCastToBool:    toBool,
 		ModuleCastToFloat32: toFloat32,
 		ModuleCastToFloat64: toFloat64,
+		ModuleCastToMap:     toMap,
+		ModuleCastToList:    toList,
+		ModuleCastToBytes:   toBytes,
+		ModuleCastToString:  toString,
+		ModuleCastToInt8:    toInt8,
+		ModuleCastToInt16:   toInt16,
+		ModuleCastToInt32:   toInt32,
+		ModuleCastToInt64:   toInt64,
+		ModuleCastToUint8:   toUint8,
+		ModuleCastToUint16:  toUint16,
+		ModuleCastToUint32:  toUint32,
+		ModuleCastToUint64:  toUint64,
 	}
 }
 
 func (app *cast) castToInt() modules.ExecuteFn {
 	return func(input map[uint]interface{}) (interface{}, error) {
-		if ins, ok := input[0]; ok {
-			if casted, ok := ins.(string); ok {
-				return strconv.Atoi(casted)
-			}
-
-			if casted, ok := ins.(uint); ok {
-				return int(casted), nil
-			}
-
-			str := fmt.Sprintf("the value was expected to contain a string or uint")
-			return nil, errors.New(str)
+		value, err := inputSignedInteger(input, 0, strconv.IntSize)
+		if err != nil {
+			return nil, err
 		}
 
-		str := fmt.Sprintf("the value was expected to be valid")
-		return nil, errors.New(str)
+		return int(value), nil
 	}
 }
 
 func (app *cast) castToUint() modules.ExecuteFn {
 	return func(input map[uint]interface{}) (interface{}, error) {
-		if ins, ok := input[0]; ok {
-			str := strings.TrimSpace(fmt.Sprintf("%s", ins))
-			intValue, err := strconv.Atoi(str)
-			if err != nil {
-				return nil, err
-			}
+		value, err := inputUnsignedInteger(input, 0, strconv.IntSize)
+		if err != nil {
+			return nil, err
+		}
 
-			return uint(intValue), nil
+		return uint(value), nil
+	}
+}
+
+func (app *cast) castToSignedInteger(bits uint) modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		value, err := inputSignedInteger(input, 0, bits)
+		if err != nil {
+			return nil, err
 		}
 
-		str := fmt.Sprintf("the value was expected to be valid")
-		return nil, errors.New(str)
+		switch bits {
+		case 8:
+			return int8(value), nil
+		case 16:
+			return int16(value), nil
+		case 32:
+			return int32(value), nil
+		default:
+			return value, nil
+		}
 	}
 }
 
-func (app *cast) castToBool() modules.ExecuteFn {
+func (app *cast) castToUnsignedInteger(bits uint) modules.ExecuteFn {
 	return func(input map[uint]interface{}) (interface{}, error) {
-		if ins, ok := input[0]; ok {
-			if casted, ok := ins.(string); ok {
-				if strings.TrimSpace(casted) == "true" {
-					return true, nil
-				}
+		value, err := inputUnsignedInteger(input, 0, bits)
+		if err != nil {
+			return nil, err
+		}
 
-				if strings.TrimSpace(casted) == "false" {
-					return false, nil
-				}
+		switch bits {
+		case 8:
+			return uint8(value), nil
+		case 16:
+			return uint16(value), nil
+		case 32:
+			return uint32(value), nil
+		default:
+			return value, nil
+		}
+	}
+}
 
-				str := fmt.Sprintf("the value was expected to contain true/false when a string is provided")
-				return nil, errors.New(str)
-			}
+func (app *cast) castToBool() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		ins, err := inputValue(input, 0)
+		if err != nil {
+			return nil, err
+		}
 
-			if casted, ok := ins.(int); ok {
-				if casted == 0 {
-					return false, nil
-				}
+		if casted, ok := ins.(bool); ok {
+			return casted, nil
+		}
 
+		if text, ok := toText(ins); ok {
+			if strings.TrimSpace(text) == "true" {
 				return true, nil
 			}
 
-			if casted, ok := ins.(uint); ok {
-				if casted == 0 {
-					return false, nil
-				}
-
-				return true, nil
+			if strings.TrimSpace(text) == "false" {
+				return false, nil
 			}
 
-			str := fmt.Sprintf("the value was expected to contain a string, int or uint")
+			str := fmt.Sprintf("the value was expected to contain true/false when a string is provided")
 			return nil, errors.New(str)
 		}
 
-		str := fmt.Sprintf("the value was expected to be valid")
+		if value, ok := toInteger(ins); ok {
+			return value.magnitude != 0, nil
+		}
+
+		if value, ok := toFloat(ins); ok {
+			return value != 0, nil
+		}
+
+		str := fmt.Sprintf("the value was expected to contain a bool, string, integer or float, %T provided", ins)
 		return nil, errors.New(str)
 	}
 }
 
 func (app *cast) castToFloat32() modules.ExecuteFn {
 	return func(input map[uint]interface{}) (interface{}, error) {
-		if ins, ok := input[0]; ok {
-			if casted, ok := ins.(string); ok {
-				floatSixtyFour, err := strconv.ParseFloat(casted, 32)
-				if err != nil {
-					return nil, err
-				}
-
-				return float32(floatSixtyFour), nil
-			}
-
-			if casted, ok := ins.(int); ok {
-				return float32(casted), nil
-			}
-
-			if casted, ok := ins.(uint); ok {
-				return float32(casted), nil
-			}
+		value, err := inputFloat(input, 0, 32)
+		if err != nil {
+			return nil, err
+		}
 
-			str := fmt.Sprintf("the value was expected to contain a string, int or uint")
+		// a finite value outside of the float32 range would silently become infinite:
+		out := float32(value)
+		if math.IsInf(float64(out), 0) && !math.IsInf(value, 0) {
+			str := fmt.Sprintf("the input at index %d was expected to contain a value (%g) within the float32 range", 0, value)
 			return nil, errors.New(str)
 		}
 
-		str := fmt.Sprintf("the value was expected to be valid")
-		return nil, errors.New(str)
+		return out, nil
 	}
 }
 
 func (app *cast) castToFloat64() modules.ExecuteFn {
 	return func(input map[uint]interface{}) (interface{}, error) {
-		if ins, ok := input[0]; ok {
-			if casted, ok := ins.(string); ok {
-				return strconv.ParseFloat(casted, 64)
-			}
+		return inputFloat(input, 0, 64)
+	}
+}
+
+func (app *cast) castToBytes() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		ins, err := inputValue(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		// the bytes are copied, so that the cast value never shares its memory with the provided value:
+		if casted, ok := ins.([]byte); ok {
+			out := make([]byte, len(casted))
+			copy(out, casted)
+			return out, nil
+		}
+
+		text, err := formatValue(ins)
+		if err != nil {
+			return nil, err
+		}
+
+		return []byte(text), nil
+	}
+}
+
+func (app *cast) castToString() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		ins, err := inputValue(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		return formatValue(ins)
+	}
+}
 
-			if casted, ok := ins.(int); ok {
-				return float64(casted), nil
+func (app *cast) castToMap() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		pairs, err := inputList(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		// the pairs are set in order, so the last pair of a repeated key wins:
+		out := map[string]interface{}{}
+		for idx, onePair := range pairs {
+			casted, ok := onePair.([]interface{})
+			if !ok || len(casted) != 2 {
+				str := fmt.Sprintf("the element at index %d was expected to contain a pair, as a list of a key and a value", idx)
+				return nil, errors.New(str)
 			}
 
-			if casted, ok := ins.(uint); ok {
-				return float64(casted), nil
+			key, ok := toKey(casted[0])
+			if !ok {
+				str := fmt.Sprintf("the pair at index %d was expected to contain a key, as bytes", idx)
+				return nil, errors.New(str)
 			}
 
-			str := fmt.Sprintf("the value was expected to contain a string, int or uint")
-			return nil, errors.New(str)
+			out[key] = casted[1]
 		}
 
-		str := fmt.Sprintf("the value was expected to be valid")
-		return nil, errors.New(str)
+		return out, nil
+	}
+}
+
+func (app *cast) castToList() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		values, err := inputMap(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		out := []interface{}{}
+		for _, oneKey := range sortedKeys(values) {
+			out = append(out, []interface{}{
+				[]byte(oneKey),
+				values[oneKey],
+			})
+		}
+
+		return out, nil
 	}
 }
+
+// String returns the integer in base 10
+func (obj integer) String() string {
+	str := strconv.FormatUint(obj.magnitude, 10)
+	if obj.isNegative && obj.magnitude != 0 {
+		return negativeSign + str
+	}
+
+	return str
+}
+
+func inputInteger(input map[uint]interface{}, index uint) (integer, error) {
+	ins, err := inputValue(input, index)
+	if err != nil {
+		return integer{}, err
+	}
+
+	if value, ok := toInteger(ins); ok {
+		return value, nil
+	}
+
+	if text, ok := toText(ins); ok {
+		return parseInteger(text)
+	}
+
+	if value, ok := toFloat(ins); ok {
+		if value != math.Trunc(value) || math.IsInf(value, 0) || math.IsNaN(value) || math.Abs(value) >= math.Exp2(64) {
+			str := fmt.Sprintf("the value (%s) cannot be cast to an integer without losing precision", strconv.FormatFloat(value, 'g', -1, 64))
+			return integer{}, errors.New(str)
+		}
+
+		return integer{
+			isNegative: value < 0,
+			magnitude:  uint64(math.Abs(value)),
+		}, nil
+	}
+
+	str := fmt.Sprintf("the input at index %d was expected to contain a string, integer or float, %T provided", index, ins)
+	return integer{}, errors.New(str)
+}
+
+// inputSignedInteger returns the input as a signed integer, if it fits in the provided amount of bits
+func inputSignedInteger(input map[uint]interface{}, index uint, bits uint) (int64, error) {
+	value, err := inputInteger(input, index)
+	if err != nil {
+		return 0, err
+	}
+
+	// the negative values reach one more than the positive values:
+	max := uint64(1)<<(bits-1) - 1
+	if value.magnitude > max && !(value.isNegative && value.magnitude == max+1) {
+		str := fmt.Sprintf("the value (%s) overflows a %d bits signed integer", value.String(), bits)
+		return 0, errors.New(str)
+	}
+
+	if value.isNegative {
+		return -int64(value.magnitude-1) - 1, nil
+	}
+
+	return int64(value.magnitude), nil
+}
+
+// inputUnsignedInteger returns the input as an unsigned integer, if it is positive and fits in the provided amount of bits
+func inputUnsignedInteger(input map[uint]interface{}, index uint, bits uint) (uint64, error) {
+	value, err := inputInteger(input, index)
+	if err != nil {
+		return 0, err
+	}
+
+	if value.isNegative && value.magnitude != 0 {
+		str := fmt.Sprintf("the value (%s) is negative and therefore cannot be cast to an unsigned integer", value.String())
+		return 0, errors.New(str)
+	}
+
+	max := uint64(math.MaxUint64) >> (64 - bits)
+	if value.magnitude > max {
+		str := fmt.Sprintf("the value (%s) overflows a %d bits unsigned integer", value.String(), bits)
+		return 0, errors.New(str)
+	}
+
+	return value.magnitude, nil
+}
+
+func inputFloat(input map[uint]interface{}, index uint, bits int) (float64, error) {
+	ins, err := inputValue(input, index)
+	if err != nil {
+		return 0, err
+	}
+
+	if value, ok := toFloat(ins); ok {
+		return value, nil
+	}
+
+	if value, ok := toInteger(ins); ok {
+		out := float64(value.magnitude)
+		if value.isNegative {
+			out = -out
+		}
+
+		return out, nil
+	}
+
+	if text, ok := toText(ins); ok {
+		return strconv.ParseFloat(strings.TrimSpace(text), bits)
+	}
+
+	str := fmt.Sprintf("the input at index %d was expected to contain a string, integer or float, %T provided", index, ins)
+	return 0, errors.New(str)
+}
+
+// parseInteger parses an integer written in base 10, or in base 16 or 2 when prefixed by 0x or 0b, after its optional sign
+func parseInteger(text string) (integer, error) {
+	digits := strings.TrimSpace(text)
+	isNegative := false
+	if strings.HasPrefix(digits, negativeSign) {
+		isNegative = true
+		digits = digits[len(negativeSign):]
+	} else if strings.HasPrefix(digits, positiveSign) {
+		digits = digits[len(positiveSign):]
+	}
+
+	base := 10
+	lowered := strings.ToLower(digits)
+	if strings.HasPrefix(lowered, hexadecimalPrefix) {
+		base = 16
+		digits = digits[len(hexadecimalPrefix):]
+	} else if strings.HasPrefix(lowered, binaryPrefix) {
+		base = 2
+		digits = digits[len(binaryPrefix):]
+	}
+
+	magnitude, err := strconv.ParseUint(digits, base, 64)
+	if err != nil {
+		reason := err
+		if casted, ok := err.(*strconv.NumError); ok {
+			reason = casted.Err
+		}
+
+		str := fmt.Sprintf("the value (%s) could not be parsed as an integer: %s", text, reason.Error())
+		return integer{}, errors.New(str)
+	}
+
+	return integer{
+		isNegative: isNegative,
+		magnitude:  magnitude,
+	}, nil
+}
+
+func toInteger(value interface{}) (integer, bool) {
+	signed := int64(0)
+	switch casted := value.(type) {
+	case uint:
+		return integer{magnitude: uint64(casted)}, true
+	case uint8:
+		return integer{magnitude: uint64(casted)}, true
+	case uint16:
+		return integer{magnitude: uint64(casted)}, true
+	case uint32:
+		return integer{magnitude: uint64(casted)}, true
+	case uint64:
+		return integer{magnitude: casted}, true
+	case int:
+		signed = int64(casted)
+	case int8:
+		signed = int64(casted)
+	case int16:
+		signed = int64(casted)
+	case int32:
+		signed = int64(casted)
+	case int64:
+		signed = casted
+	default:
+		return integer{}, false
+	}
+
+	// the magnitude of the smallest int64 does not fit in an int64, but its two's complement is its magnitude:
+	if signed < 0 {
+		return integer{
+			isNegative: true,
+			magnitude:  uint64(^signed) + 1,
+		}, true
+	}
+
+	return integer{magnitude: uint64(signed)}, true
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	if casted, ok := value.(float32); ok {
+		return float64(casted), true
+	}
+
+	if casted, ok := value.(float64); ok {
+		return casted, true
+	}
+
+	return 0, false
+}
+
+func toText(value interface{}) (string, bool) {
+	if casted, ok := value.([]byte); ok {
+		return string(casted), true
+	}
+
+	if casted, ok := value.(string); ok {
+		return casted, true
+	}
+
+	return "", false
+}
+
+// formatValue formats a scalar value as text, numbers in base 10
+func formatValue(value interface{}) (string, error) {
+	if text, ok := toText(value); ok {
+		return text, nil
+	}
+
+	if casted, ok := value.(bool); ok {
+		return strconv.FormatBool(casted), nil
+	}
+
+	if casted, ok := toInteger(value); ok {
+		return casted.String(), nil
+	}
+
+	if casted, ok := value.(float32); ok {
+		return strconv.FormatFloat(float64(casted), 'g', -1, 32), nil
+	}
+
+	if casted, ok := value.(float64); ok {
+		return strconv.FormatFloat(casted, 'g', -1, 64), nil
+	}
+
+	str := fmt.Sprintf("the value was expected to contain a string, bytes, bool, integer or float, %T provided", value)
+	return "", errors.New(str)
+}
@@ -0,0 +1,170 @@
+package modules
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestCast_matrix_Success(t *testing.T) {
+	// every source contains the same number, so every numeric cast returns it in its own type:
+	sources := map[string]interface{}{
+		"int":     int(42),
+		"int8":    int8(42),
+		"int16":   int16(42),
+		"int32":   int32(42),
+		"int64":   int64(42),
+		"uint":    uint(42),
+		"uint8":   uint8(42),
+		"uint16":  uint16(42),
+		"uint32":  uint32(42),
+		"uint64":  uint64(42),
+		"float32": float32(42),
+		"float64": float64(42),
+		"bytes":   []byte("42"),
+		"string":  "42",
+	}
+
+	targets := map[uint]interface{}{
+		ModuleCastToInt:     int(42),
+		ModuleCastToInt8:    int8(42),
+		ModuleCastToInt16:   int16(42),
+		ModuleCastToInt32:   int32(42),
+		ModuleCastToInt64:   int64(42),
+		ModuleCastToUint:    uint(42),
+		ModuleCastToUint8:   uint8(42),
+		ModuleCastToUint16:  uint16(42),
+		ModuleCastToUint32:  uint32(42),
+		ModuleCastToUint64:  uint64(42),
+		ModuleCastToFloat32: float32(42),
+		ModuleCastToFloat64: float64(42),
+		ModuleCastToBytes:   []byte("42"),
+		ModuleCastToString:  "42",
+		ModuleCastToBool:    true,
+	}
+
+	fns := createCast().Execute()
+	for sourceName, oneSource := range sources {
+		for oneModule, oneExpected := range targets {
+			output, err := fns[oneModule](map[uint]interface{}{
+				0: oneSource,
+			})
+
+			if oneModule == ModuleCastToBool && (sourceName == "bytes" || sourceName == "string") {
+				if err == nil {
+					t.Errorf("%s to %s: the error was expected to be valid, nil returned", sourceName, moduleNames[oneModule])
+				}
+
+				continue
+			}
+
+			if err != nil {
+				t.Errorf("%s to %s: the error was expected to be nil, error returned: %s", sourceName, moduleNames[oneModule], err.Error())
+				continue
+			}
+
+			if !reflect.DeepEqual(output, oneExpected) {
+				t.Errorf("%s to %s: the output was expected to be %v (%T), %v (%T) returned", sourceName, moduleNames[oneModule], oneExpected, oneExpected, output, output)
+				continue
+			}
+		}
+	}
+}
+
+func TestCast_Success(t *testing.T) {
+	testCases := []struct {
+		name     string
+		module   uint
+		value    interface{}
+		expected interface{}
+		isError  bool
+	}{
+		{name: "bool to bytes", module: ModuleCastToBytes, value: true, expected: []byte("true")},
+		{name: "bool to string", module: ModuleCastToString, value: false, expected: "false"},
+		{name: "bool to bool", module: ModuleCastToBool, value: true, expected: true},
+		{name: "bool to int", module: ModuleCastToInt, value: true, isError: true},
+		{name: "text to bool", module: ModuleCastToBool, value: []byte(" false "), expected: false},
+		{name: "zero to bool", module: ModuleCastToBool, value: int8(0), expected: false},
+		{name: "float to bytes", module: ModuleCastToBytes, value: 2.5, expected: []byte("2.5")},
+		{name: "float32 to string", module: ModuleCastToString, value: float32(0.1), expected: "0.1"},
+		{name: "negative to bytes", module: ModuleCastToBytes, value: int16(-7), expected: []byte("-7")},
+		{name: "list to bytes", module: ModuleCastToBytes, value: []interface{}{}, isError: true},
+		{name: "list to int", module: ModuleCastToInt, value: []interface{}{}, isError: true},
+		{name: "hexadecimal", module: ModuleCastToUint8, value: []byte("0xfF"), expected: uint8(255)},
+		{name: "negative hexadecimal", module: ModuleCastToInt8, value: "-0x80", expected: int8(-128)},
+		{name: "binary", module: ModuleCastToUint16, value: []byte("0b101"), expected: uint16(5)},
+		{name: "signed text", module: ModuleCastToInt, value: []byte("+12"), expected: 12},
+		{name: "padded text", module: ModuleCastToUint, value: " 12\n", expected: uint(12)},
+		{name: "invalid text", module: ModuleCastToInt, value: []byte("invalid"), isError: true},
+		{name: "invalid binary", module: ModuleCastToInt, value: []byte("0b102"), isError: true},
+		{name: "int8, maximum", module: ModuleCastToInt8, value: 127, expected: int8(127)},
+		{name: "int8, minimum", module: ModuleCastToInt8, value: -128, expected: int8(-128)},
+		{name: "int8, overflow", module: ModuleCastToInt8, value: 128, isError: true},
+		{name: "int8, underflow", module: ModuleCastToInt8, value: -129, isError: true},
+		{name: "int16, overflow", module: ModuleCastToInt16, value: uint(math.MaxInt16 + 1), isError: true},
+		{name: "int32, overflow", module: ModuleCastToInt32, value: int64(math.MinInt32 - 1), isError: true},
+		{name: "int64, minimum", module: ModuleCastToInt64, value: int64(math.MinInt64), expected: int64(math.MinInt64)},
+		{name: "int64, overflow", module: ModuleCastToInt64, value: uint64(math.MaxInt64 + 1), isError: true},
+		{name: "uint8, overflow", module: ModuleCastToUint8, value: 256, isError: true},
+		{name: "uint16, overflow", module: ModuleCastToUint16, value: "65536", isError: true},
+		{name: "uint32, overflow", module: ModuleCastToUint32, value: uint64(math.MaxUint32 + 1), isError: true},
+		{name: "uint64, maximum", module: ModuleCastToUint64, value: "18446744073709551615", expected: uint64(math.MaxUint64)},
+		{name: "uint64, overflow", module: ModuleCastToUint64, value: "18446744073709551616", isError: true},
+		{name: "uint, negative", module: ModuleCastToUint, value: -1, isError: true},
+		{name: "uint, negative text", module: ModuleCastToUint, value: []byte("-1"), isError: true},
+		{name: "uint, negative zero", module: ModuleCastToUint, value: "-0", expected: uint(0)},
+		{name: "int, fractional float", module: ModuleCastToInt, value: 2.5, isError: true},
+		{name: "int, negative float", module: ModuleCastToInt, value: float32(-3), expected: -3},
+		{name: "int, infinite float", module: ModuleCastToInt, value: math.Inf(1), isError: true},
+		{name: "float32, text", module: ModuleCastToFloat32, value: []byte("2.5"), expected: float32(2.5)},
+		{name: "float32, maximum", module: ModuleCastToFloat32, value: float64(math.MaxFloat32), expected: float32(math.MaxFloat32)},
+		{name: "float32, overflow", module: ModuleCastToFloat32, value: float64(math.MaxFloat32) * 2, isError: true},
+		{name: "float32, negative overflow", module: ModuleCastToFloat32, value: -math.MaxFloat64, isError: true},
+		{name: "float32, infinite", module: ModuleCastToFloat32, value: math.Inf(-1), expected: float32(math.Inf(-1))},
+		{name: "float64, negative", module: ModuleCastToFloat64, value: int8(-2), expected: float64(-2)},
+		{name: "float64, invalid text", module: ModuleCastToFloat64, value: "invalid", isError: true},
+	}
+
+	fns := createCast().Execute()
+	for _, oneTestCase := range testCases {
+		output, err := fns[oneTestCase.module](map[uint]interface{}{
+			0: oneTestCase.value,
+		})
+
+		if oneTestCase.isError {
+			if err == nil {
+				t.Errorf("%s: the error was expected to be valid, nil returned", oneTestCase.name)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: the error was expected to be nil, error returned: %s", oneTestCase.name, err.Error())
+			continue
+		}
+
+		if !reflect.DeepEqual(output, oneTestCase.expected) {
+			t.Errorf("%s: the output was expected to be %v (%T), %v (%T) returned", oneTestCase.name, oneTestCase.expected, oneTestCase.expected, output, output)
+			continue
+		}
+	}
+}
+
+func TestCast_bytesAreCopied_Success(t *testing.T) {
+	value := []byte("data")
+	output, err := createCast().Execute()[ModuleCastToBytes](map[uint]interface{}{
+		0: value,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	value[0] = 'D'
+	if string(output.([]byte)) != "data" {
+		t.Errorf("the cast bytes were expected to not share their memory with the provided bytes")
+		return
+	}
+}
@@ -0,0 +1,116 @@
+package modules
+
+import (
+	"errors"
+	"fmt"
+
+	interpreter_applications "github.com/steve-care-software/interpreter/applications"
+	"github.com/steve-care-software/interpreter/domain/programs/modules"
+)
+
+type caught struct {
+}
+
+func createCaught() *caught {
+	out := caught{}
+	return &out
+}
+
+// Execute executes the application
+func (app *caught) Execute() map[uint]modules.ExecuteFn {
+	return map[uint]modules.ExecuteFn{
+		ModuleErrorMessage:     app.errorMessage(),
+		ModuleErrorHasModule:   app.errorHasModule(),
+		ModuleErrorModuleName:  app.errorModuleName(),
+		ModuleErrorModuleIndex: app.errorModuleIndex(),
+		ModuleErrorPosition:    app.errorPosition(),
+	}
+}
+
+func (app *caught) errorMessage() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		pErr, err := app.fetchError(input)
+		if err != nil {
+			return nil, err
+		}
+
+		return []byte(pErr.Message), nil
+	}
+}
+
+func (app *caught) errorHasModule() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		pErr, err := app.fetchError(input)
+		if err != nil {
+			return nil, err
+		}
+
+		return pErr.Module != nil, nil
+	}
+}
+
+func (app *caught) errorModuleName() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		pErr, err := app.fetchModuleError(input)
+		if err != nil {
+			return nil, err
+		}
+
+		return pErr.ModuleName, nil
+	}
+}
+
+func (app *caught) errorModuleIndex() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		pErr, err := app.fetchModuleError(input)
+		if err != nil {
+			return nil, err
+		}
+
+		return *pErr.Module, nil
+	}
+}
+
+func (app *caught) errorPosition() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		pErr, err := app.fetchError(input)
+		if err != nil {
+			return nil, err
+		}
+
+		position := []interface{}{}
+		for _, oneIndex := range pErr.Position {
+			position = append(position, oneIndex)
+		}
+
+		return position, nil
+	}
+}
+
+func (app *caught) fetchModuleError(input map[uint]interface{}) (*interpreter_applications.Error, error) {
+	pErr, err := app.fetchError(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if pErr.Module == nil {
+		str := fmt.Sprintf("the error (message: %s) was not raised by a module", pErr.Message)
+		return nil, errors.New(str)
+	}
+
+	return pErr, nil
+}
+
+func (app *caught) fetchError(input map[uint]interface{}) (*interpreter_applications.Error, error) {
+	if ins, ok := input[0]; ok {
+		if casted, ok := ins.(*interpreter_applications.Error); ok {
+			return casted, nil
+		}
+
+		str := fmt.Sprintf("the value was expected to contain a caught error, %T provided", ins)
+		return nil, errors.New(str)
+	}
+
+	str := fmt.Sprintf("the value was expected to be valid")
+	return nil, errors.New(str)
+}
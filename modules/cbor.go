@@ -0,0 +1,364 @@
+package modules
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+const (
+	cborMajorUnsigned = 0
+	cborMajorNegative = 1
+	cborMajorBytes    = 2
+	cborMajorText     = 3
+	cborMajorArray    = 4
+	cborMajorMap      = 5
+	cborMajorSimple   = 7
+)
+
+const (
+	cborFalse   = 20
+	cborTrue    = 21
+	cborFloat16 = 25
+	cborFloat32 = 26
+	cborFloat64 = 27
+)
+
+// cborMaxDepth represents the maximum amount of nested lists and maps decoded, so that a malicious input cannot exhaust the stack
+const cborMaxDepth = 512
+
+// encodeCBOR encodes a value in the deterministic encoding of CBOR (RFC 8949, section 4.2): the arguments are as short as possible,
+// the floats are encoded in the shortest of their 32 or 64 bits representation preserving their value,
+// and the keys of the maps are encoded as text, sorted by their encoded bytes
+func encodeCBOR(buffer *bytes.Buffer, value interface{}) error {
+	if casted, ok := value.(bool); ok {
+		if casted {
+			buffer.WriteByte(cborMajorSimple<<5 | cborTrue)
+			return nil
+		}
+
+		buffer.WriteByte(cborMajorSimple<<5 | cborFalse)
+		return nil
+	}
+
+	if casted, ok := toInteger(value); ok {
+		if casted.isNegative && casted.magnitude != 0 {
+			writeCBORHead(buffer, cborMajorNegative, casted.magnitude-1)
+			return nil
+		}
+
+		writeCBORHead(buffer, cborMajorUnsigned, casted.magnitude)
+		return nil
+	}
+
+	if casted, ok := toFloat(value); ok {
+		if float64(float32(casted)) == casted || math.IsNaN(casted) {
+			buffer.WriteByte(cborMajorSimple<<5 | cborFloat32)
+			binary.Write(buffer, binary.BigEndian, math.Float32bits(float32(casted)))
+			return nil
+		}
+
+		buffer.WriteByte(cborMajorSimple<<5 | cborFloat64)
+		binary.Write(buffer, binary.BigEndian, math.Float64bits(casted))
+		return nil
+	}
+
+	if casted, ok := value.([]byte); ok {
+		writeCBORHead(buffer, cborMajorBytes, uint64(len(casted)))
+		buffer.Write(casted)
+		return nil
+	}
+
+	if casted, ok := value.(string); ok {
+		writeCBORHead(buffer, cborMajorText, uint64(len(casted)))
+		buffer.WriteString(casted)
+		return nil
+	}
+
+	if casted, ok := value.([]interface{}); ok {
+		writeCBORHead(buffer, cborMajorArray, uint64(len(casted)))
+		for _, oneElement := range casted {
+			err := encodeCBOR(buffer, oneElement)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if casted, ok := value.(map[string]interface{}); ok {
+		type entry struct {
+			key   []byte
+			value interface{}
+		}
+
+		entries := []entry{}
+		for oneKey, oneValue := range casted {
+			keyBuffer := bytes.Buffer{}
+			writeCBORHead(&keyBuffer, cborMajorText, uint64(len(oneKey)))
+			keyBuffer.WriteString(oneKey)
+			entries = append(entries, entry{
+				key:   keyBuffer.Bytes(),
+				value: oneValue,
+			})
+		}
+
+		sort.Slice(entries, func(first int, second int) bool {
+			return bytes.Compare(entries[first].key, entries[second].key) < 0
+		})
+
+		writeCBORHead(buffer, cborMajorMap, uint64(len(entries)))
+		for _, oneEntry := range entries {
+			buffer.Write(oneEntry.key)
+			err := encodeCBOR(buffer, oneEntry.value)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	str := fmt.Sprintf("the value (%T) cannot be encoded in CBOR", value)
+	return errors.New(str)
+}
+
+// writeCBORHead writes the major type and its argument, in as few bytes as possible
+func writeCBORHead(buffer *bytes.Buffer, major byte, argument uint64) {
+	head := major << 5
+	switch {
+	case argument < 24:
+		buffer.WriteByte(head | byte(argument))
+	case argument <= math.MaxUint8:
+		buffer.WriteByte(head | 24)
+		buffer.WriteByte(byte(argument))
+	case argument <= math.MaxUint16:
+		buffer.WriteByte(head | 25)
+		binary.Write(buffer, binary.BigEndian, uint16(argument))
+	case argument <= math.MaxUint32:
+		buffer.WriteByte(head | 26)
+		binary.Write(buffer, binary.BigEndian, uint32(argument))
+	default:
+		buffer.WriteByte(head | 27)
+		binary.Write(buffer, binary.BigEndian, argument)
+	}
+}
+
+// cborDecoder decodes the values of the script value model from CBOR: the indefinite lengths, the tags, null and undefined are rejected
+type cborDecoder struct {
+	data   []byte
+	offset int
+}
+
+func decodeCBOR(data []byte) (interface{}, error) {
+	decoder := cborDecoder{
+		data:   data,
+		offset: 0,
+	}
+
+	value, err := decoder.value(0)
+	if err != nil {
+		return nil, err
+	}
+
+	if decoder.offset != len(data) {
+		str := fmt.Sprintf("the CBOR data contains %d bytes after its value", len(data)-decoder.offset)
+		return nil, errors.New(str)
+	}
+
+	return value, nil
+}
+
+func (app *cborDecoder) value(depth uint) (interface{}, error) {
+	if depth > cborMaxDepth {
+		str := fmt.Sprintf("the CBOR data nests more than %d lists and maps", cborMaxDepth)
+		return nil, errors.New(str)
+	}
+
+	initial, err := app.read(1)
+	if err != nil {
+		return nil, err
+	}
+
+	major := initial[0] >> 5
+	additional := initial[0] & 0x1f
+	if major == cborMajorSimple {
+		return app.simple(additional)
+	}
+
+	argument, err := app.argument(additional)
+	if err != nil {
+		return nil, err
+	}
+
+	switch major {
+	case cborMajorUnsigned:
+		if argument > math.MaxUint64>>(64-strconv.IntSize) {
+			str := fmt.Sprintf("the CBOR unsigned integer (%d) overflows an unsigned integer", argument)
+			return nil, errors.New(str)
+		}
+
+		return uint(argument), nil
+	case cborMajorNegative:
+		if argument > math.MaxInt64>>(64-strconv.IntSize) {
+			str := fmt.Sprintf("the CBOR negative integer (-1-%d) overflows a signed integer", argument)
+			return nil, errors.New(str)
+		}
+
+		return -1 - int(argument), nil
+	case cborMajorBytes, cborMajorText:
+		content, err := app.read(argument)
+		if err != nil {
+			return nil, err
+		}
+
+		return copyBytes(content), nil
+	case cborMajorArray:
+		out := []interface{}{}
+		for idx := uint64(0); idx < argument; idx++ {
+			element, err := app.value(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+
+			out = append(out, element)
+		}
+
+		return out, nil
+	case cborMajorMap:
+		out := map[string]interface{}{}
+		for idx := uint64(0); idx < argument; idx++ {
+			key, err := app.value(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+
+			casted, ok := key.([]byte)
+			if !ok {
+				str := fmt.Sprintf("the CBOR map key (%T) was expected to contain bytes or text", key)
+				return nil, errors.New(str)
+			}
+
+			if _, ok := out[string(casted)]; ok {
+				str := fmt.Sprintf("the CBOR map contains the key (%s) more than once", casted)
+				return nil, errors.New(str)
+			}
+
+			element, err := app.value(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+
+			out[string(casted)] = element
+		}
+
+		return out, nil
+	}
+
+	str := fmt.Sprintf("the CBOR major type (%d) is not supported", major)
+	return nil, errors.New(str)
+}
+
+func (app *cborDecoder) simple(additional byte) (interface{}, error) {
+	switch additional {
+	case cborFalse:
+		return false, nil
+	case cborTrue:
+		return true, nil
+	case cborFloat16:
+		content, err := app.read(2)
+		if err != nil {
+			return nil, err
+		}
+
+		return halfToFloat64(binary.BigEndian.Uint16(content)), nil
+	case cborFloat32:
+		content, err := app.read(4)
+		if err != nil {
+			return nil, err
+		}
+
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(content))), nil
+	case cborFloat64:
+		content, err := app.read(8)
+		if err != nil {
+			return nil, err
+		}
+
+		return math.Float64frombits(binary.BigEndian.Uint64(content)), nil
+	}
+
+	str := fmt.Sprintf("the CBOR simple value (%d) is not supported", additional)
+	return nil, errors.New(str)
+}
+
+func (app *cborDecoder) argument(additional byte) (uint64, error) {
+	if additional < 24 {
+		return uint64(additional), nil
+	}
+
+	sizes := map[byte]uint64{
+		24: 1,
+		25: 2,
+		26: 4,
+		27: 8,
+	}
+
+	size, ok := sizes[additional]
+	if !ok {
+		str := fmt.Sprintf("the CBOR additional information (%d) is not supported, the indefinite lengths being rejected", additional)
+		return 0, errors.New(str)
+	}
+
+	content, err := app.read(size)
+	if err != nil {
+		return 0, err
+	}
+
+	out := uint64(0)
+	for _, oneByte := range content {
+		out = out<<8 | uint64(oneByte)
+	}
+
+	return out, nil
+}
+
+func (app *cborDecoder) read(amount uint64) ([]byte, error) {
+	remaining := uint64(len(app.data) - app.offset)
+	if amount > remaining {
+		str := fmt.Sprintf("the CBOR data was expected to contain %d more bytes, %d remaining", amount, remaining)
+		return nil, errors.New(str)
+	}
+
+	out := app.data[app.offset : app.offset+int(amount)]
+	app.offset += int(amount)
+	return out, nil
+}
+
+// halfToFloat64 converts a half precision float (IEEE 754 binary16) to a float64
+func halfToFloat64(half uint16) float64 {
+	sign := 1.0
+	if half&0x8000 != 0 {
+		sign = -1.0
+	}
+
+	exponent := int(half>>10) & 0x1f
+	fraction := float64(half & 0x3ff)
+	switch exponent {
+	case 0:
+		return sign * math.Ldexp(fraction, -24)
+	case 0x1f:
+		if fraction == 0 {
+			return math.Inf(int(sign))
+		}
+
+		return math.NaN()
+	}
+
+	return sign * math.Ldexp(fraction+1024, exponent-25)
+}
@@ -0,0 +1,159 @@
+package modules
+
+import (
+	"os"
+	"testing"
+)
+
+func TestCondition_nestedBranches_Success(t *testing.T) {
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		module @castToBool:11;;
+		@castToBool $firstToBool;;
+		@castToBool $secondToBool;;
+
+		-> $first;;
+		-> $second;;
+		<- $output;;
+
+		attach $first:0 $firstToBool;;
+		$isFirst = execute $firstToBool;;
+
+		attach $second:0 $secondToBool;;
+		$isSecond = execute $secondToBool;;
+
+		if $isFirst {
+			if $isSecond {
+				$output = both;;
+			} else {
+				$output = first only;;
+			};;
+		} else {
+			if $isSecond {
+				$output = second only;;
+			};;
+
+			$result = none;;
+		};;
+
+		if $isFirst {} else {
+			if $isSecond {} else {
+				$output = $result;;
+			};;
+		};;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expectations := map[[2]string]string{
+		{"true", "true"}:   " both",
+		{"true", "false"}:  " first only",
+		{"false", "true"}:  " second only",
+		{"false", "false"}: " none",
+	}
+
+	for input, expected := range expectations {
+		output, err := application.Interpret([]interface{}{input[0], input[1]}, program)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(output) != 1 {
+			t.Errorf("%d output was expected, %d returned", 1, len(output))
+			return
+		}
+
+		if string(output[0].([]byte)) != expected {
+			t.Errorf("the output was expected to be '%s', '%s' returned (input: %v)", expected, output[0], input)
+			return
+		}
+	}
+}
+
+func TestCondition_withNonBoolValue_returnsError(t *testing.T) {
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		-> $value;;
+		if $value {
+			$output = yes;;
+		};;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = application.Interpret([]interface{}{"true"}, program)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestCondition_declaringParameterInBranch_returnsError(t *testing.T) {
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		-> $value;;
+		if $value {
+			-> $other;;
+		};;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, _, err = application.Parse(treeIns)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
@@ -1,9 +1,14 @@
 package modules
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"reflect"
+	"sort"
 
+	interpreter_applications "github.com/steve-care-software/interpreter/applications"
 	"github.com/steve-care-software/interpreter/domain/programs/modules"
 )
 
@@ -16,12 +21,31 @@ func createContainers() *containers {
 }
 
 // Execute executes the application
-func (app *containers) Execute() map[uint]modules.ExecuteFn {
+func (app *containers) Execute() map[uint]modules.ExecuteContextFn {
 	list := app.list()
 	fetchElement := app.fetchElement()
-	return map[uint]modules.ExecuteFn{
-		ModuleList:             list,
-		ModuleListFetchElement: fetchElement,
+	length := app.listLength()
+	appendFn := app.listAppend()
+	prepend := app.listPrepend()
+	concat := app.listConcat()
+	slice := app.listSlice()
+	reverse := app.listReverse()
+	contains := app.listContains()
+	indexOf := app.listIndexOf()
+	unique := app.listUnique()
+	return map[uint]modules.ExecuteContextFn{
+		ModuleList:             withContext(list),
+		ModuleListFetchElement: withContext(fetchElement),
+		ModuleListLength:       withContext(length),
+		ModuleListAppend:       withContext(appendFn),
+		ModuleListPrepend:      withContext(prepend),
+		ModuleListConcat:       withContext(concat),
+		ModuleListSlice:        withContext(slice),
+		ModuleListReverse:      withContext(reverse),
+		ModuleListContains:     withContext(contains),
+		ModuleListIndexOf:      withContext(indexOf),
+		ModuleListUnique:       withContext(unique),
+		ModuleListSort:         app.listSort(),
 	}
 }
 
@@ -30,7 +54,7 @@ func (app *containers) fetchElement() modules.ExecuteFn {
 		if index, ok := input[0].(uint); ok {
 			if value, ok := input[1].([]interface{}); ok {
 				amount := uint(len(value))
-				if index+1 < amount {
+				if index >= amount {
 					str := fmt.Sprintf("the element at index %d could not be fetched because the list only contains %d elements", index, amount)
 					return nil, errors.New(str)
 				}
@@ -47,6 +71,235 @@ func (app *containers) fetchElement() modules.ExecuteFn {
 	}
 }
 
+func (app *containers) listLength() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		list, err := inputList(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		return uint(len(list)), nil
+	}
+}
+
+func (app *containers) listAppend() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		list, err := inputList(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := inputValue(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		out := make([]interface{}, 0, len(list)+1)
+		out = append(out, list...)
+		return append(out, value), nil
+	}
+}
+
+func (app *containers) listPrepend() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		list, err := inputList(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := inputValue(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		out := make([]interface{}, 0, len(list)+1)
+		out = append(out, value)
+		return append(out, list...), nil
+	}
+}
+
+func (app *containers) listConcat() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		first, err := inputList(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		second, err := inputList(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		out := make([]interface{}, 0, len(first)+len(second))
+		out = append(out, first...)
+		return append(out, second...), nil
+	}
+}
+
+func (app *containers) listSlice() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		list, err := inputList(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		from, err := inputUint(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		to, err := inputUint(input, 2)
+		if err != nil {
+			return nil, err
+		}
+
+		if from > to {
+			str := fmt.Sprintf("the list could not be sliced because its start index (%d) is greater than its end index (%d)", from, to)
+			return nil, errors.New(str)
+		}
+
+		amount := uint(len(list))
+		if to > amount {
+			str := fmt.Sprintf("the list could not be sliced to index %d because it only contains %d elements", to, amount)
+			return nil, errors.New(str)
+		}
+
+		// the slice is copied, so that appending to it never writes in the provided list:
+		out := make([]interface{}, to-from)
+		copy(out, list[from:to])
+		return out, nil
+	}
+}
+
+func (app *containers) listReverse() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		list, err := inputList(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		out := make([]interface{}, len(list))
+		for idx, oneElement := range list {
+			out[len(list)-1-idx] = oneElement
+		}
+
+		return out, nil
+	}
+}
+
+func (app *containers) listContains() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		list, err := inputList(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := inputValue(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		_, isFound := indexOf(list, value)
+		return isFound, nil
+	}
+}
+
+func (app *containers) listIndexOf() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		list, err := inputList(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := inputValue(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		if index, isFound := indexOf(list, value); isFound {
+			return index, nil
+		}
+
+		str := fmt.Sprintf("the value (%v) could not be found in the list", value)
+		return nil, errors.New(str)
+	}
+}
+
+func (app *containers) listUnique() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		list, err := inputList(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		// the first occurrence of every element is kept, in order:
+		out := []interface{}{}
+		for _, oneElement := range list {
+			if _, isFound := indexOf(out, oneElement); isFound {
+				continue
+			}
+
+			out = append(out, oneElement)
+		}
+
+		return out, nil
+	}
+}
+
+func (app *containers) listSort() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
+		list, err := inputList(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		comparison, err := inputValue(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		if !interpreter_applications.IsCallable(comparison) {
+			str := fmt.Sprintf("the input at index %d was expected to contain a callable", 1)
+			return nil, errors.New(str)
+		}
+
+		// the comparison returns true when its first input must be placed before its second input:
+		var failure error
+		out := make([]interface{}, len(list))
+		copy(out, list)
+		sort.SliceStable(out, func(first int, second int) bool {
+			if failure != nil {
+				return false
+			}
+
+			result, err := interpreter_applications.ExecuteCallable(ctx, comparison, []interface{}{
+				out[first],
+				out[second],
+			})
+
+			if err != nil {
+				failure = err
+				return false
+			}
+
+			isBefore, ok := result.(bool)
+			if !ok {
+				str := fmt.Sprintf("the comparison was expected to return a bool, %T returned", result)
+				failure = errors.New(str)
+				return false
+			}
+
+			return isBefore
+		})
+
+		if failure != nil {
+			return nil, failure
+		}
+
+		return out, nil
+	}
+}
+
 func (app *containers) list() modules.ExecuteFn {
 	return func(input map[uint]interface{}) (interface{}, error) {
 		findValueAtIndex := func(index uint, list map[uint]interface{}) (interface{}, error) {
@@ -77,3 +330,97 @@ func (app *containers) list() modules.ExecuteFn {
 		return values, nil
 	}
 }
+
+func inputValue(input map[uint]interface{}, index uint) (interface{}, error) {
+	if value, ok := input[index]; ok {
+		return value, nil
+	}
+
+	str := fmt.Sprintf("the input at index %d was expected to contain a value", index)
+	return nil, errors.New(str)
+}
+
+func inputList(input map[uint]interface{}, index uint) ([]interface{}, error) {
+	if value, ok := input[index].([]interface{}); ok {
+		return value, nil
+	}
+
+	str := fmt.Sprintf("the input at index %d was expected to contain a list", index)
+	return nil, errors.New(str)
+}
+
+func inputUint(input map[uint]interface{}, index uint) (uint, error) {
+	if value, ok := input[index].(uint); ok {
+		return value, nil
+	}
+
+	str := fmt.Sprintf("the input at index %d was expected to contain a uint", index)
+	return 0, errors.New(str)
+}
+
+// indexOf returns the index of the first element equal to the value, false if the list does not contain it
+func indexOf(list []interface{}, value interface{}) (uint, bool) {
+	for idx, oneElement := range list {
+		if isEqual(oneElement, value) {
+			return uint(idx), true
+		}
+	}
+
+	return 0, false
+}
+
+// isEqual returns true when both values are equal, the numbers being compared by their value whatever their types
+func isEqual(first interface{}, second interface{}) bool {
+	firstNumber, isFirstNumber := toNumber(first)
+	secondNumber, isSecondNumber := toNumber(second)
+	if isFirstNumber && isSecondNumber {
+		comparison, err := compareNumbers(firstNumber, secondNumber)
+		if err != nil {
+			// a NaN is never equal to anything:
+			return false
+		}
+
+		return comparison == 0
+	}
+
+	if firstBytes, ok := first.([]byte); ok {
+		if secondBytes, ok := second.([]byte); ok {
+			return bytes.Equal(firstBytes, secondBytes)
+		}
+
+		return false
+	}
+
+	if firstList, ok := first.([]interface{}); ok {
+		secondList, ok := second.([]interface{})
+		if !ok || len(firstList) != len(secondList) {
+			return false
+		}
+
+		for idx, oneElement := range firstList {
+			if !isEqual(oneElement, secondList[idx]) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if firstMap, ok := first.(map[string]interface{}); ok {
+		secondMap, ok := second.(map[string]interface{})
+		if !ok || len(firstMap) != len(secondMap) {
+			return false
+		}
+
+		for oneKey, oneValue := range firstMap {
+			secondValue, ok := secondMap[oneKey]
+			if !ok || !isEqual(oneValue, secondValue) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return reflect.DeepEqual(first, second)
+}
@@ -0,0 +1,163 @@
+package modules
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestContainers_Success(t *testing.T) {
+	declarations := `
+		module @listFetchElement:1;;
+		module @listLength:41;;
+		module @listAppend:42;;
+		module @listPrepend:43;;
+		module @listConcat:44;;
+		module @listSlice:45;;
+		module @listReverse:46;;
+		module @listContains:47;;
+		module @listIndexOf:48;;
+		module @listUnique:49;;
+		module @listSort:50;;
+		module @castToFloat64:13;;
+
+		<- $output;;
+
+		$values = [1, 2, 3];;
+		$empty = [];;
+		$zero = 0;;
+		$one = 1;;
+		$two = 2;;
+		$three = 3;;
+		$four = 4;;
+		$threeFloat = @castToFloat64($three);;
+		$isTrue = true;;
+		$bools = [false, true, false, true];;
+		$names = ["first", "second"];;
+		$second = "second";;
+		$others = [4, 5];;
+		$duplicates = [1, 2, 1, [3], 2, [3]];;
+		$trueFirst = {
+			-> $first;;
+			-> $second;;
+			<- $isBefore;;
+
+			$isBefore = false;;
+			if $first {
+				if $second {} else {
+					$isBefore = true;;
+				};;
+			};;
+		};;
+	`
+
+	testCases := []struct {
+		name     string
+		script   string
+		expected interface{}
+		isError  bool
+	}{
+		{name: "fetchElement", script: "$output = @listFetchElement($two, $values);;", expected: uint(3)},
+		{name: "fetchElement, first", script: "$output = @listFetchElement($zero, $values);;", expected: uint(1)},
+		{name: "fetchElement, out of bounds", script: "$output = @listFetchElement($three, $values);;", isError: true},
+		{name: "length", script: "$output = @listLength($values);;", expected: uint(3)},
+		{name: "length, empty", script: "$output = @listLength($empty);;", expected: uint(0)},
+		{name: "append", script: "$output = @listAppend($values, $four);;", expected: []interface{}{uint(1), uint(2), uint(3), uint(4)}},
+		{name: "prepend", script: "$output = @listPrepend($values, $zero);;", expected: []interface{}{uint(0), uint(1), uint(2), uint(3)}},
+		{name: "concat", script: "$output = @listConcat($values, $others);;", expected: []interface{}{uint(1), uint(2), uint(3), uint(4), uint(5)}},
+		{name: "concat, not a list", script: "$output = @listConcat($values, $four);;", isError: true},
+		{name: "slice", script: "$output = @listSlice($values, $one, $three);;", expected: []interface{}{uint(2), uint(3)}},
+		{name: "slice, empty", script: "$output = @listSlice($values, $three, $three);;", expected: []interface{}{}},
+		{name: "slice, inverted bounds", script: "$output = @listSlice($values, $two, $one);;", isError: true},
+		{name: "slice, out of bounds", script: "$output = @listSlice($values, $one, $four);;", isError: true},
+		{name: "reverse", script: "$output = @listReverse($values);;", expected: []interface{}{uint(3), uint(2), uint(1)}},
+		{name: "contains", script: "$output = @listContains($values, $two);;", expected: true},
+		{name: "contains, bytes", script: "$output = @listContains($names, $second);;", expected: true},
+		{name: "contains, missing", script: "$output = @listContains($values, $four);;", expected: false},
+		{name: "contains, float", script: "$output = @listContains($values, $threeFloat);;", expected: true},
+		{name: "indexOf", script: "$output = @listIndexOf($values, $three);;", expected: uint(2)},
+		{name: "indexOf, float", script: "$output = @listIndexOf($values, $threeFloat);;", expected: uint(2)},
+		{name: "indexOf, missing", script: "$output = @listIndexOf($values, $four);;", isError: true},
+		{name: "unique", script: "$output = @listUnique($duplicates);;", expected: []interface{}{uint(1), uint(2), []interface{}{uint(3)}}},
+		{name: "sort", script: "$output = @listSort($bools, $trueFirst);;", expected: []interface{}{true, true, false, false}},
+		{name: "sort, not a callable", script: "$output = @listSort($values, $isTrue);;", isError: true},
+		{name: "sort, comparison failing", script: "$output = @listSort($values, $trueFirst);;", isError: true},
+	}
+
+	for _, oneTestCase := range testCases {
+		output, err := executeContainersScript(declarations + oneTestCase.script)
+		if oneTestCase.isError {
+			if err == nil {
+				t.Errorf("%s: the error was expected to be valid, nil returned", oneTestCase.name)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: the error was expected to be nil, error returned: %s", oneTestCase.name, err.Error())
+			continue
+		}
+
+		if !reflect.DeepEqual(output[0], oneTestCase.expected) {
+			t.Errorf("%s: the output was expected to be %v, %v returned", oneTestCase.name, oneTestCase.expected, output[0])
+			continue
+		}
+	}
+}
+
+func TestContainers_doesNotMutateInput_Success(t *testing.T) {
+	script := `
+		module @listAppend:42;;
+		module @listReverse:46;;
+
+		<- $values;;
+		<- $appended;;
+		<- $reversed;;
+
+		$values = [1, 2];;
+		$three = 3;;
+		$appended = @listAppend($values, $three);;
+		$reversed = @listReverse($values);;
+	`
+
+	output, err := executeContainersScript(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := []interface{}{
+		[]interface{}{uint(1), uint(2)},
+		[]interface{}{uint(1), uint(2), uint(3)},
+		[]interface{}{uint(2), uint(1)},
+	}
+
+	if !reflect.DeepEqual(output, expected) {
+		t.Errorf("the output was expected to be %v, %v returned", expected, output)
+		return
+	}
+}
+
+func executeContainersScript(script string) ([]interface{}, error) {
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		return nil, err
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		return nil, err
+	}
+
+	return application.Interpret([]interface{}{}, program)
+}
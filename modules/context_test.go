@@ -0,0 +1,137 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	interpreter_applications "github.com/steve-care-software/interpreter/applications"
+	"github.com/steve-care-software/interpreter/domain/programs"
+	"github.com/steve-care-software/interpreter/domain/programs/modules"
+)
+
+func TestContext_cancelled_throughInterpreter_returnsError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	modulesIns := newModules(map[uint]modules.ExecuteContextFn{
+		0: func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
+			cancel()
+			return nil, nil
+		},
+	})
+
+	moduleIns, _ := modulesIns.Fetch(0)
+	appIns, _ := programs.NewApplicationBuilder().Create().WithIndex(0).WithModule(moduleIns).Now()
+	first, _ := programs.NewInstructionBuilder().Create().WithExecution(appIns).Now()
+	second, _ := programs.NewInstructionBuilder().Create().WithExecution(appIns).Now()
+	instructions, _ := programs.NewInstructionsBuilder().Create().WithList([]programs.Instruction{
+		first,
+		second,
+	}).Now()
+
+	program, err := programs.NewBuilder().Create().WithInstructions(instructions).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	interpreterApp := interpreter_applications.NewApplication(func(name []byte) string {
+		return string(name)
+	})
+
+	_, err = interpreterApp.ExecuteContext(ctx, []interface{}{}, program)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("the error was expected to be context.Canceled, returned: %v", err)
+		return
+	}
+}
+
+func TestContext_fileLock_wait_returnsError(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	fns := createFile(basePath, 1024, createMeter(nil), nil, nil).Execute()
+	pLock, err := fns[ModuleFileLock](context.Background(), map[uint]interface{}{
+		0: "data.lock",
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer fns[ModuleFileUnLock](context.Background(), map[uint]interface{}{
+		0: pLock,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = fns[ModuleFileLock](ctx, map[uint]interface{}{
+		0: "data.lock",
+		1: true,
+	})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("the error was expected to be context.DeadlineExceeded, returned: %v", err)
+		return
+	}
+}
+
+func TestContext_fileRead_cancelled_returnsError(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	err = ioutil.WriteFile(filepath.Join(basePath, "data.txt"), []byte("0123456789"), 0644)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	fns := createFile(basePath, 2, createMeter(nil), nil, nil).Execute()
+	pConn, err := fns[ModuleFileOpen](context.Background(), map[uint]interface{}{
+		0: []byte("data.txt"),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	data, err := fns[ModuleFileRead](context.Background(), map[uint]interface{}{
+		0: pConn,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if string(data.([]byte)) != "0123456789" {
+		t.Errorf("the data was expected to be '%s', '%s' returned", "0123456789", data)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = fns[ModuleFileRead](ctx, map[uint]interface{}{
+		0: pConn,
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("the error was expected to be context.Canceled, returned: %v", err)
+		return
+	}
+}
@@ -0,0 +1,385 @@
+package modules
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/steve-care-software/interpreter/domain/programs/modules"
+	"golang.org/x/crypto/blake2b"
+)
+
+// maxRandomBytes represents the maximum amount of random bytes generated at once
+const maxRandomBytes = 1024 * 1024
+
+type crypto struct {
+	file *file
+}
+
+func createCrypto(
+	file *file,
+) *crypto {
+	out := crypto{
+		file: file,
+	}
+
+	return &out
+}
+
+// Execute executes the application
+func (app *crypto) Execute() map[uint]modules.ExecuteContextFn {
+	hashSHA256 := app.hash(sha256.New)
+	hashSHA512 := app.hash(sha512.New)
+	hashBLAKE2b := app.hash(newBLAKE2b)
+	hmacSHA256 := app.hmac(sha256.New)
+	hmacSHA512 := app.hmac(sha512.New)
+	constantTimeCompare := app.constantTimeCompare()
+	return map[uint]modules.ExecuteContextFn{
+		ModuleHashSHA256:          withContext(hashSHA256),
+		ModuleHashSHA512:          withContext(hashSHA512),
+		ModuleHashBLAKE2b:         withContext(hashBLAKE2b),
+		ModuleHMACSHA256:          withContext(hmacSHA256),
+		ModuleHMACSHA512:          withContext(hmacSHA512),
+		ModuleEd25519GenerateKey:  app.ed25519GenerateKey(),
+		ModuleEd25519PublicKey:    app.ed25519PublicKey(),
+		ModuleEd25519Sign:         app.ed25519Sign(),
+		ModuleEd25519Verify:       withContext(app.ed25519Verify()),
+		ModuleConstantTimeCompare: withContext(constantTimeCompare),
+		ModuleRandomBytes:         app.randomBytes(),
+		ModulePBKDF2Key:           withContext(app.pbkdf2Key()),
+		ModuleAESSeal:             withContext(app.aesSeal()),
+		ModuleAESOpen:             withContext(app.aesOpen()),
+		ModuleBoxGenerateKey:      app.boxGenerateKey(),
+		ModuleBoxPublicKey:        app.boxPublicKey(),
+		ModuleBoxSeal:             withContext(app.boxSeal()),
+		ModuleBoxOpen:             app.boxOpen(),
+	}
+}
+
+// newBLAKE2b returns a new unkeyed BLAKE2b-512 hash, which cannot fail without a key
+func newBLAKE2b() hash.Hash {
+	hasher, _ := blake2b.New512(nil)
+	return hasher
+}
+
+func (app *crypto) hash(fn func() hash.Hash) modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		data, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		hasher := fn()
+		hasher.Write(data)
+		return hasher.Sum(nil), nil
+	}
+}
+
+func (app *crypto) hmac(fn func() hash.Hash) modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		key, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := inputText(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		mac := hmac.New(fn, key)
+		mac.Write(data)
+		return mac.Sum(nil), nil
+	}
+}
+
+// ed25519GenerateKey generates a key pair, writes its private key to the provided path, then returns its public key
+func (app *crypto) ed25519GenerateKey() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
+		path, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+
+		err = app.file.writeKey(ctx, string(path), privateKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return []byte(publicKey), nil
+	}
+}
+
+func (app *crypto) ed25519PublicKey() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
+		privateKey, err := app.ed25519PrivateKey(ctx, input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		return []byte(privateKey.Public().(ed25519.PublicKey)), nil
+	}
+}
+
+func (app *crypto) ed25519Sign() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
+		privateKey, err := app.ed25519PrivateKey(ctx, input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		message, err := inputText(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		return ed25519.Sign(privateKey, message), nil
+	}
+}
+
+func (app *crypto) ed25519Verify() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		publicKey, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(publicKey) != ed25519.PublicKeySize {
+			str := fmt.Sprintf("the public key was expected to contain %d bytes, %d provided", ed25519.PublicKeySize, len(publicKey))
+			return nil, errors.New(str)
+		}
+
+		message, err := inputText(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		signature, err := inputText(input, 2)
+		if err != nil {
+			return nil, err
+		}
+
+		return ed25519.Verify(ed25519.PublicKey(publicKey), message, signature), nil
+	}
+}
+
+// constantTimeCompare compares two secrets in a time that does not depend on their content, only on their length
+func (app *crypto) constantTimeCompare() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		first, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		second, err := inputText(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		return subtle.ConstantTimeCompare(first, second) == 1, nil
+	}
+}
+
+func (app *crypto) randomBytes() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
+		amount, err := inputUint(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		if amount > maxRandomBytes {
+			str := fmt.Sprintf("the amount of random bytes (%d) was expected to be at most %d", amount, maxRandomBytes)
+			return nil, errors.New(str)
+		}
+
+		// the random bytes are read from the system's source:
+		err = meterFromContext(ctx, app.file.meter).read(amount)
+		if err != nil {
+			return nil, err
+		}
+
+		out := make([]byte, amount)
+		_, err = io.ReadFull(rand.Reader, out)
+		if err != nil {
+			return nil, err
+		}
+
+		return out, nil
+	}
+}
+
+// pbkdf2Key derives a key from a passphrase and a salt, sized to seal data with AES-256
+func (app *crypto) pbkdf2Key() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		passphrase, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		salt, err := inputText(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(salt) < pbkdf2MinSaltSize {
+			str := fmt.Sprintf("the salt was expected to contain at least %d bytes, %d provided", pbkdf2MinSaltSize, len(salt))
+			return nil, errors.New(str)
+		}
+
+		iterations, err := inputUint(input, 2)
+		if err != nil {
+			return nil, err
+		}
+
+		if iterations < pbkdf2MinIterations {
+			str := fmt.Sprintf("the iterations were expected to be at least %d, %d provided", pbkdf2MinIterations, iterations)
+			return nil, errors.New(str)
+		}
+
+		if iterations > pbkdf2MaxIterations {
+			str := fmt.Sprintf("the iterations were expected to be at most %d, %d provided", pbkdf2MaxIterations, iterations)
+			return nil, errors.New(str)
+		}
+
+		return pbkdf2SHA256(passphrase, salt, iterations, sealKeySize), nil
+	}
+}
+
+func (app *crypto) aesSeal() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		key, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := inputText(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		return sealAES(key, data)
+	}
+}
+
+func (app *crypto) aesOpen() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		key, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		sealed, err := inputText(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		return openAES(key, sealed)
+	}
+}
+
+// boxGenerateKey generates the key of a sealed box recipient, writes its private key to the provided path, then returns its public key
+func (app *crypto) boxGenerateKey() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
+		path, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		privateKey, publicKey, err := generateBoxKey()
+		if err != nil {
+			return nil, err
+		}
+
+		err = app.file.writeKey(ctx, string(path), privateKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return publicKey, nil
+	}
+}
+
+func (app *crypto) boxPublicKey() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
+		path, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		privateKey, err := app.file.readKey(ctx, string(path))
+		if err != nil {
+			return nil, err
+		}
+
+		return boxPublicKey(privateKey)
+	}
+}
+
+func (app *crypto) boxSeal() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		publicKey, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := inputText(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		return sealBox(publicKey, data)
+	}
+}
+
+func (app *crypto) boxOpen() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
+		path, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		sealed, err := inputText(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		privateKey, err := app.file.readKey(ctx, string(path))
+		if err != nil {
+			return nil, err
+		}
+
+		return openBox(privateKey, sealed)
+	}
+}
+
+func (app *crypto) ed25519PrivateKey(ctx context.Context, input map[uint]interface{}, index uint) (ed25519.PrivateKey, error) {
+	path, err := inputText(input, index)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := app.file.readKey(ctx, string(path))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(key) != ed25519.PrivateKeySize {
+		str := fmt.Sprintf("the private key (path: %s) was expected to contain %d bytes, %d read", path, ed25519.PrivateKeySize, len(key))
+		return nil, errors.New(str)
+	}
+
+	return ed25519.PrivateKey(key), nil
+}
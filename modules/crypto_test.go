@@ -0,0 +1,232 @@
+package modules
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/steve-care-software/rodan/capabilities"
+	"github.com/steve-care-software/rodan/limits"
+)
+
+func TestCrypto_Success(t *testing.T) {
+	testCases := []struct {
+		name     string
+		module   uint
+		input    []interface{}
+		expected interface{}
+		isError  bool
+	}{
+		{name: "SHA-256", module: ModuleHashSHA256, input: []interface{}{[]byte("abc")}, expected: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+		{name: "SHA-512", module: ModuleHashSHA512, input: []interface{}{[]byte("abc")}, expected: "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f"},
+		{name: "BLAKE2b", module: ModuleHashBLAKE2b, input: []interface{}{[]byte("abc")}, expected: "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923"},
+		{name: "SHA-256, not bytes", module: ModuleHashSHA256, input: []interface{}{uint(1)}, isError: true},
+		{name: "HMAC-SHA-256", module: ModuleHMACSHA256, input: []interface{}{[]byte("Jefe"), []byte("what do ya want for nothing?")}, expected: "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843"},
+		{name: "HMAC-SHA-512", module: ModuleHMACSHA512, input: []interface{}{[]byte("Jefe"), []byte("what do ya want for nothing?")}, expected: "164b7a7bfcf819e2e395fbe73b56e0a387bd64222e831fd610270cd7ea2505549758bf75c05a994a6d034f65f8f0e6fdcaeab1a34d4a6b4b636e070a38bce737"},
+		{name: "HMAC, missing data", module: ModuleHMACSHA256, input: []interface{}{[]byte("Jefe")}, isError: true},
+		{name: "constant time compare", module: ModuleConstantTimeCompare, input: []interface{}{[]byte("secret"), []byte("secret")}, expected: true},
+		{name: "constant time compare, different", module: ModuleConstantTimeCompare, input: []interface{}{[]byte("secret"), []byte("secreT")}, expected: false},
+		{name: "constant time compare, different length", module: ModuleConstantTimeCompare, input: []interface{}{[]byte("secret"), []byte("secrets")}, expected: false},
+		{name: "random bytes, too many", module: ModuleRandomBytes, input: []interface{}{uint(maxRandomBytes + 1)}, isError: true},
+		{name: "verify, invalid public key", module: ModuleEd25519Verify, input: []interface{}{[]byte("short"), []byte("message"), []byte("signature")}, isError: true},
+	}
+
+	fns := createCrypto(createFile(os.TempDir(), 1024, createMeter(nil), nil, nil)).Execute()
+	for _, oneTestCase := range testCases {
+		input := map[uint]interface{}{}
+		for idx, oneInput := range oneTestCase.input {
+			input[uint(idx)] = oneInput
+		}
+
+		output, err := fns[oneTestCase.module](context.Background(), input)
+		if oneTestCase.isError {
+			if err == nil {
+				t.Errorf("%s: the error was expected to be valid, nil returned", oneTestCase.name)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: the error was expected to be nil, error returned: %s", oneTestCase.name, err.Error())
+			continue
+		}
+
+		// the digests are compared in hex:
+		if digest, ok := output.([]byte); ok {
+			output = hex.EncodeToString(digest)
+		}
+
+		if !reflect.DeepEqual(output, oneTestCase.expected) {
+			t.Errorf("%s: the output was expected to be %v, %v returned", oneTestCase.name, oneTestCase.expected, output)
+			continue
+		}
+	}
+}
+
+func TestCrypto_randomBytes_withReadBytesLimit_returnsError(t *testing.T) {
+	limitsIns, _ := limits.NewBuilder().Create().WithReadBytes(16).Now()
+	fns := createCrypto(createFile(os.TempDir(), 1024, createMeter(limitsIns).start(), nil, nil)).Execute()
+	output, err := fns[ModuleRandomBytes](context.Background(), map[uint]interface{}{
+		0: uint(16),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(output.([]byte)) != 16 {
+		t.Errorf("the random bytes were expected to contain %d bytes, %d returned", 16, len(output.([]byte)))
+		return
+	}
+
+	_, err = fns[ModuleRandomBytes](context.Background(), map[uint]interface{}{
+		0: uint(1),
+	})
+
+	if !errors.Is(err, limits.ErrReadBytesExceeded) {
+		t.Errorf("the error was expected to be ErrReadBytesExceeded, returned: %v", err)
+		return
+	}
+}
+
+func TestCrypto_withEd25519_Success(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	ctx := context.Background()
+	fns := createCrypto(createFile(basePath, 1024, createMeter(nil), nil, nil)).Execute()
+	publicKey, err := fns[ModuleEd25519GenerateKey](ctx, map[uint]interface{}{
+		0: []byte("signer.key"),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	pInfo, err := os.Stat(filepath.Join(basePath, "signer.key"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if pInfo.Mode().Perm() != fileKeyPermissions {
+		t.Errorf("the private key was expected to be readable by its owner only, mode %s returned", pInfo.Mode().Perm())
+		return
+	}
+
+	// an existing key is never replaced:
+	_, err = fns[ModuleEd25519GenerateKey](ctx, map[uint]interface{}{
+		0: []byte("signer.key"),
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	// the keys cannot be written outside of the base path:
+	_, err = fns[ModuleEd25519GenerateKey](ctx, map[uint]interface{}{
+		0: []byte("../signer.key"),
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	storedPublicKey, err := fns[ModuleEd25519PublicKey](ctx, map[uint]interface{}{
+		0: []byte("signer.key"),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !reflect.DeepEqual(storedPublicKey, publicKey) {
+		t.Errorf("the public key of the stored key was expected to be the generated public key")
+		return
+	}
+
+	message := []byte("message")
+	signature, err := fns[ModuleEd25519Sign](ctx, map[uint]interface{}{
+		0: []byte("signer.key"),
+		1: message,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	verifications := map[string]bool{
+		"message":  true,
+		"Message":  false,
+		"message!": false,
+	}
+
+	for oneMessage, isExpected := range verifications {
+		isValid, err := fns[ModuleEd25519Verify](ctx, map[uint]interface{}{
+			0: publicKey,
+			1: []byte(oneMessage),
+			2: signature,
+		})
+
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if isValid.(bool) != isExpected {
+			t.Errorf("the verification of the message (%s) was expected to be %t", oneMessage, isExpected)
+			return
+		}
+	}
+
+	_, err = fns[ModuleEd25519Sign](ctx, map[uint]interface{}{
+		0: []byte("missing.key"),
+		1: message,
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestCrypto_withoutFileCapabilities_returnsError(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	capabilitiesIns, err := capabilities.NewAdapter().ToCapabilities([]byte("crypto"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	fns := createCrypto(createFile(basePath, 1024, createMeter(nil), capabilitiesIns, nil)).Execute()
+	_, err = fns[ModuleEd25519GenerateKey](context.Background(), map[uint]interface{}{
+		0: []byte("signer.key"),
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
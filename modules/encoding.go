@@ -0,0 +1,262 @@
+package modules
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/steve-care-software/interpreter/domain/programs/modules"
+)
+
+type encoding struct {
+}
+
+func createEncoding() *encoding {
+	out := encoding{}
+	return &out
+}
+
+// Execute executes the application
+func (app *encoding) Execute() map[uint]modules.ExecuteFn {
+	hexEncode := app.encode(func(value []byte) ([]byte, error) {
+		return []byte(hex.EncodeToString(value)), nil
+	})
+
+	hexDecode := app.decode(func(value []byte) (interface{}, error) {
+		return hex.DecodeString(string(value))
+	})
+
+	base64Encode := app.encode(func(value []byte) ([]byte, error) {
+		return []byte(base64.StdEncoding.EncodeToString(value)), nil
+	})
+
+	base64Decode := app.decode(func(value []byte) (interface{}, error) {
+		return base64.StdEncoding.DecodeString(string(value))
+	})
+
+	base64URLEncode := app.encode(func(value []byte) ([]byte, error) {
+		return []byte(base64.URLEncoding.EncodeToString(value)), nil
+	})
+
+	base64URLDecode := app.decode(func(value []byte) (interface{}, error) {
+		return base64.URLEncoding.DecodeString(string(value))
+	})
+
+	return map[uint]modules.ExecuteFn{
+		ModuleHexEncode:       hexEncode,
+		ModuleHexDecode:       hexDecode,
+		ModuleBase64Encode:    base64Encode,
+		ModuleBase64Decode:    base64Decode,
+		ModuleBase64URLEncode: base64URLEncode,
+		ModuleBase64URLDecode: base64URLDecode,
+		ModuleJSONEncode:      app.jsonEncode(),
+		ModuleJSONDecode:      app.decode(decodeJSON),
+		ModuleCBOREncode:      app.cborEncode(),
+		ModuleCBORDecode:      app.decode(decodeCBOR),
+	}
+}
+
+func (app *encoding) encode(fn func(value []byte) ([]byte, error)) modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		value, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		return fn(value)
+	}
+}
+
+func (app *encoding) decode(fn func(value []byte) (interface{}, error)) modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		value, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		return fn(value)
+	}
+}
+
+func (app *encoding) jsonEncode() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		value, err := inputValue(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		converted, err := toJSON(value)
+		if err != nil {
+			return nil, err
+		}
+
+		// the keys of the objects are sorted by the encoder, so the output is deterministic:
+		buffer := bytes.Buffer{}
+		encoder := json.NewEncoder(&buffer)
+		encoder.SetEscapeHTML(false)
+		err = encoder.Encode(converted)
+		if err != nil {
+			return nil, err
+		}
+
+		return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+	}
+}
+
+func (app *encoding) cborEncode() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		value, err := inputValue(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		buffer := bytes.Buffer{}
+		err = encodeCBOR(&buffer, value)
+		if err != nil {
+			return nil, err
+		}
+
+		return buffer.Bytes(), nil
+	}
+}
+
+// toJSON converts a script value to a value the JSON encoder writes as expected: the bytes are written as text, so they must be valid UTF-8
+func toJSON(value interface{}) (interface{}, error) {
+	if casted, ok := value.(bool); ok {
+		return casted, nil
+	}
+
+	if casted, ok := toInteger(value); ok {
+		return json.Number(casted.String()), nil
+	}
+
+	if casted, ok := toFloat(value); ok {
+		if math.IsInf(casted, 0) || math.IsNaN(casted) {
+			str := fmt.Sprintf("the float (%s) cannot be encoded in JSON", strconv.FormatFloat(casted, 'g', -1, 64))
+			return nil, errors.New(str)
+		}
+
+		return casted, nil
+	}
+
+	if casted, ok := toText(value); ok {
+		if !utf8.ValidString(casted) {
+			return nil, errors.New("the bytes cannot be encoded in JSON because they are not valid UTF-8 text")
+		}
+
+		return casted, nil
+	}
+
+	if casted, ok := value.([]interface{}); ok {
+		out := []interface{}{}
+		for _, oneElement := range casted {
+			element, err := toJSON(oneElement)
+			if err != nil {
+				return nil, err
+			}
+
+			out = append(out, element)
+		}
+
+		return out, nil
+	}
+
+	if casted, ok := value.(map[string]interface{}); ok {
+		out := map[string]interface{}{}
+		for oneKey, oneValue := range casted {
+			if !utf8.ValidString(oneKey) {
+				return nil, errors.New("the map key cannot be encoded in JSON because it is not valid UTF-8 text")
+			}
+
+			element, err := toJSON(oneValue)
+			if err != nil {
+				return nil, err
+			}
+
+			out[oneKey] = element
+		}
+
+		return out, nil
+	}
+
+	str := fmt.Sprintf("the value (%T) cannot be encoded in JSON", value)
+	return nil, errors.New(str)
+}
+
+// decodeJSON decodes JSON to a script value: the integers are decoded as uint, or int when negative, the other numbers as float64 and the strings as bytes
+func decodeJSON(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	err := decoder.Decode(&value)
+	if err != nil {
+		return nil, err
+	}
+
+	var remaining interface{}
+	if err := decoder.Decode(&remaining); err != io.EOF {
+		return nil, errors.New("the JSON data was expected to contain only one value")
+	}
+
+	return fromJSON(value)
+}
+
+func fromJSON(value interface{}) (interface{}, error) {
+	switch casted := value.(type) {
+	case bool:
+		return casted, nil
+	case string:
+		return []byte(casted), nil
+	case json.Number:
+		text := casted.String()
+		if !strings.ContainsAny(text, ".eE") {
+			if strings.HasPrefix(text, negativeSign) {
+				return strconv.Atoi(text)
+			}
+
+			out, err := strconv.ParseUint(text, 10, strconv.IntSize)
+			if err != nil {
+				return nil, err
+			}
+
+			return uint(out), nil
+		}
+
+		return casted.Float64()
+	case []interface{}:
+		out := []interface{}{}
+		for _, oneElement := range casted {
+			element, err := fromJSON(oneElement)
+			if err != nil {
+				return nil, err
+			}
+
+			out = append(out, element)
+		}
+
+		return out, nil
+	case map[string]interface{}:
+		out := map[string]interface{}{}
+		for oneKey, oneValue := range casted {
+			element, err := fromJSON(oneValue)
+			if err != nil {
+				return nil, err
+			}
+
+			out[oneKey] = element
+		}
+
+		return out, nil
+	}
+
+	return nil, errors.New("the JSON null cannot be decoded because the scripts have no null value")
+}
@@ -0,0 +1,192 @@
+package modules
+
+import (
+	"encoding/hex"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestEncoding_Success(t *testing.T) {
+	testCases := []struct {
+		name     string
+		module   uint
+		value    interface{}
+		expected interface{}
+		isError  bool
+	}{
+		{name: "hex encode", module: ModuleHexEncode, value: []byte{0x00, 0xab, 0xff}, expected: []byte("00abff")},
+		{name: "hex decode", module: ModuleHexDecode, value: []byte("00ABff"), expected: []byte{0x00, 0xab, 0xff}},
+		{name: "hex decode, invalid", module: ModuleHexDecode, value: []byte("0g"), isError: true},
+		{name: "hex encode, not bytes", module: ModuleHexEncode, value: uint(1), isError: true},
+		{name: "base64 encode", module: ModuleBase64Encode, value: []byte{0xfb, 0xff}, expected: []byte("+/8=")},
+		{name: "base64 decode", module: ModuleBase64Decode, value: []byte("+/8="), expected: []byte{0xfb, 0xff}},
+		{name: "base64 decode, URL alphabet", module: ModuleBase64Decode, value: []byte("-_8="), isError: true},
+		{name: "base64 URL encode", module: ModuleBase64URLEncode, value: []byte{0xfb, 0xff}, expected: []byte("-_8=")},
+		{name: "base64 URL decode", module: ModuleBase64URLDecode, value: []byte("-_8="), expected: []byte{0xfb, 0xff}},
+		{name: "base64 URL decode, invalid", module: ModuleBase64URLDecode, value: []byte("+/8="), isError: true},
+		{name: "JSON encode", module: ModuleJSONEncode, value: map[string]interface{}{
+			"name":  []byte("<rodan>"),
+			"age":   uint(3),
+			"tags":  []interface{}{int8(-1), 2.5, true},
+			"empty": map[string]interface{}{},
+		}, expected: []byte(`{"age":3,"empty":{},"name":"<rodan>","tags":[-1,2.5,true]}`)},
+		{name: "JSON encode, invalid UTF-8", module: ModuleJSONEncode, value: []byte{0xff}, isError: true},
+		{name: "JSON encode, infinite float", module: ModuleJSONEncode, value: math.Inf(1), isError: true},
+		{name: "JSON encode, unsupported value", module: ModuleJSONEncode, value: struct{}{}, isError: true},
+		{name: "JSON decode", module: ModuleJSONDecode, value: []byte(`{"age": 3, "tags": [-1, 2.5, true, "x"]}`), expected: map[string]interface{}{
+			"age":  uint(3),
+			"tags": []interface{}{-1, 2.5, true, []byte("x")},
+		}},
+		{name: "JSON decode, exponent", module: ModuleJSONDecode, value: []byte(`1e2`), expected: 100.0},
+		{name: "JSON decode, null", module: ModuleJSONDecode, value: []byte(`[null]`), isError: true},
+		{name: "JSON decode, many values", module: ModuleJSONDecode, value: []byte(`1 2`), isError: true},
+		{name: "JSON decode, trailing bracket", module: ModuleJSONDecode, value: []byte(`1 }`), isError: true},
+		{name: "JSON decode, invalid", module: ModuleJSONDecode, value: []byte(`{`), isError: true},
+		{name: "CBOR decode, half float", module: ModuleCBORDecode, value: cborHex(t, "f93e00"), expected: 1.5},
+		{name: "CBOR decode, text", module: ModuleCBORDecode, value: cborHex(t, "6449455446"), expected: []byte("IETF")},
+		{name: "CBOR decode, negative", module: ModuleCBORDecode, value: cborHex(t, "3903e7"), expected: -1000},
+		{name: "CBOR decode, indefinite length", module: ModuleCBORDecode, value: cborHex(t, "9f01ff"), isError: true},
+		{name: "CBOR decode, tag", module: ModuleCBORDecode, value: cborHex(t, "c11a514b67b0"), isError: true},
+		{name: "CBOR decode, null", module: ModuleCBORDecode, value: cborHex(t, "f6"), isError: true},
+		{name: "CBOR decode, truncated", module: ModuleCBORDecode, value: cborHex(t, "4401"), isError: true},
+		{name: "CBOR decode, trailing bytes", module: ModuleCBORDecode, value: cborHex(t, "0101"), isError: true},
+		{name: "CBOR decode, integer map key", module: ModuleCBORDecode, value: cborHex(t, "a10102"), isError: true},
+		{name: "CBOR decode, repeated map key", module: ModuleCBORDecode, value: cborHex(t, "a2616101616102"), isError: true},
+		{name: "CBOR encode, unsupported value", module: ModuleCBOREncode, value: struct{}{}, isError: true},
+	}
+
+	fns := createEncoding().Execute()
+	for _, oneTestCase := range testCases {
+		output, err := fns[oneTestCase.module](map[uint]interface{}{
+			0: oneTestCase.value,
+		})
+
+		if oneTestCase.isError {
+			if err == nil {
+				t.Errorf("%s: the error was expected to be valid, nil returned", oneTestCase.name)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: the error was expected to be nil, error returned: %s", oneTestCase.name, err.Error())
+			continue
+		}
+
+		if !reflect.DeepEqual(output, oneTestCase.expected) {
+			t.Errorf("%s: the output was expected to be %v, %v returned", oneTestCase.name, oneTestCase.expected, output)
+			continue
+		}
+	}
+}
+
+func TestEncoding_withCBOR_Success(t *testing.T) {
+	// the expected encodings are the examples of the RFC 8949 (appendix A), encoded deterministically:
+	testCases := []struct {
+		value    interface{}
+		expected string
+	}{
+		{value: uint(0), expected: "00"},
+		{value: uint(23), expected: "17"},
+		{value: uint(24), expected: "1818"},
+		{value: uint(1000000), expected: "1a000f4240"},
+		{value: uint64(math.MaxUint64), expected: "1bffffffffffffffff"},
+		{value: -1, expected: "20"},
+		{value: int16(-1000), expected: "3903e7"},
+		{value: 100000.0, expected: "fa47c35000"},
+		{value: 1.1, expected: "fb3ff199999999999a"},
+		{value: false, expected: "f4"},
+		{value: true, expected: "f5"},
+		{value: []byte{0x01, 0x02, 0x03, 0x04}, expected: "4401020304"},
+		{value: "IETF", expected: "6449455446"},
+		{value: []interface{}{}, expected: "80"},
+		{value: []interface{}{uint(1), []interface{}{uint(2), uint(3)}}, expected: "8201820203"},
+		{value: map[string]interface{}{"b": []interface{}{uint(2), uint(3)}, "a": uint(1)}, expected: "a26161016162820203"},
+		{value: map[string]interface{}{"aa": uint(2), "b": uint(1)}, expected: "a261620162616102"},
+	}
+
+	fns := createEncoding().Execute()
+	for _, oneTestCase := range testCases {
+		output, err := fns[ModuleCBOREncode](map[uint]interface{}{
+			0: oneTestCase.value,
+		})
+
+		if err != nil {
+			t.Errorf("%v: the error was expected to be nil, error returned: %s", oneTestCase.value, err.Error())
+			continue
+		}
+
+		if hex.EncodeToString(output.([]byte)) != oneTestCase.expected {
+			t.Errorf("%v: the encoding was expected to be %s, %x returned", oneTestCase.value, oneTestCase.expected, output)
+			continue
+		}
+	}
+}
+
+func TestEncoding_roundTrip_Success(t *testing.T) {
+	value := map[string]interface{}{
+		"name":     []byte("rodan"),
+		"version":  uint(3),
+		"offset":   -12,
+		"ratio":    0.25,
+		"isActive": true,
+		"tags": []interface{}{
+			[]byte("first"),
+			map[string]interface{}{
+				"nested": []interface{}{},
+			},
+		},
+	}
+
+	pairs := [][2]uint{
+		{ModuleJSONEncode, ModuleJSONDecode},
+		{ModuleCBOREncode, ModuleCBORDecode},
+	}
+
+	fns := createEncoding().Execute()
+	for _, onePair := range pairs {
+		encoded, err := fns[onePair[0]](map[uint]interface{}{
+			0: value,
+		})
+
+		if err != nil {
+			t.Errorf("%s: the error was expected to be nil, error returned: %s", moduleNames[onePair[0]], err.Error())
+			continue
+		}
+
+		// the encoding is deterministic, so encoding the same value again returns the same bytes:
+		again, err := fns[onePair[0]](map[uint]interface{}{
+			0: value,
+		})
+
+		if err != nil || !reflect.DeepEqual(encoded, again) {
+			t.Errorf("%s: the encoding was expected to be deterministic", moduleNames[onePair[0]])
+			continue
+		}
+
+		decoded, err := fns[onePair[1]](map[uint]interface{}{
+			0: encoded,
+		})
+
+		if err != nil {
+			t.Errorf("%s: the error was expected to be nil, error returned: %s", moduleNames[onePair[1]], err.Error())
+			continue
+		}
+
+		if !reflect.DeepEqual(decoded, value) {
+			t.Errorf("%s: the decoded value was expected to be %v, %v returned", moduleNames[onePair[1]], value, decoded)
+			continue
+		}
+	}
+}
+
+func cborHex(t *testing.T, value string) []byte {
+	out, err := hex.DecodeString(value)
+	if err != nil {
+		t.Fatalf("the error was expected to be nil, error returned: %s", err.Error())
+	}
+
+	return out
+}
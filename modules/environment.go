@@ -0,0 +1,129 @@
+package modules
+
+import (
+	"sync"
+
+	"github.com/steve-care-software/ast/domain/grammars"
+	"github.com/steve-care-software/interpreter/domain/programs/modules"
+	"github.com/steve-care-software/query/domain/queries"
+	"github.com/steve-care-software/rodan/capabilities"
+	vm_applications "github.com/steve-care-software/vm/applications"
+)
+
+const allCapabilitiesKey = "*"
+
+type environment struct {
+	grammar       grammars.Grammar
+	query         queries.Query
+	absBasePath   string
+	chunkSize     uint
+	meter         *meter
+	encryptionKey []byte
+	adapter       capabilities.Adapter
+	mutex         sync.Mutex
+	applications  map[string]vm_applications.Application
+	modules       map[string]modules.Modules
+	importMutex   sync.Mutex
+	imports       map[string]*imported
+}
+
+func createEnvironment(
+	grammar grammars.Grammar,
+	query queries.Query,
+	absBasePath string,
+	chunkSize uint,
+	meter *meter,
+	encryptionKey []byte,
+) *environment {
+	out := environment{
+		grammar:       grammar,
+		query:         query,
+		absBasePath:   absBasePath,
+		chunkSize:     chunkSize,
+		meter:         meter,
+		encryptionKey: encryptionKey,
+		adapter:       capabilities.NewAdapter(),
+		applications:  map[string]vm_applications.Application{},
+		modules:       map[string]modules.Modules{},
+		imports:       map[string]*imported{},
+	}
+
+	return &out
+}
+
+// application returns the vm application granted the provided capabilities, nil granting every capability
+func (app *environment) application(capabilitiesIns capabilities.Capabilities) vm_applications.Application {
+	vmApp, _ := app.fetch(capabilitiesIns)
+	return vmApp
+}
+
+// fetchModulesFn returns the func fetching the modules granted the provided capabilities, nil granting every capability
+func (app *environment) fetchModulesFn(capabilitiesIns capabilities.Capabilities) vm_applications.FetchModulesFn {
+	return func() (modules.Modules, error) {
+		_, modulesIns := app.fetch(capabilitiesIns)
+		return modulesIns, nil
+	}
+}
+
+// restrict returns the capabilities granted by the caller that are declared in the script header, if any
+func (app *environment) restrict(caller capabilities.Capabilities, script []byte) (capabilities.Capabilities, error) {
+	header, isDeclared, err := app.adapter.FromScriptHeader(script)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isDeclared {
+		return caller, nil
+	}
+
+	return intersect(caller, header), nil
+}
+
+func (app *environment) fetch(capabilitiesIns capabilities.Capabilities) (vm_applications.Application, modules.Modules) {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+
+	key := capabilitiesKey(capabilitiesIns)
+	if vmApp, ok := app.applications[key]; ok {
+		return vmApp, app.modules[key]
+	}
+
+	var modulesIns modules.Modules
+	vmApp := newApplication(app.grammar, app.query, func() (modules.Modules, error) {
+		return modulesIns, nil
+	}, app.importFn(capabilitiesIns, nil))
+
+	moduleFuncs := newModulesFuncs(app.absBasePath, app.chunkSize, app.meter, capabilitiesIns, app.encryptionKey)
+	for idx, fn := range createVM(vmApp, app, capabilitiesIns).Execute() {
+		moduleFuncs[idx] = fn
+	}
+
+	modulesIns = newModules(moduleFuncs)
+	if capabilitiesIns != nil {
+		modulesIns = createGrantedModules(modulesIns, capabilitiesIns)
+	}
+
+	app.applications[key] = vmApp
+	app.modules[key] = modulesIns
+	return vmApp, modulesIns
+}
+
+func capabilitiesKey(capabilitiesIns capabilities.Capabilities) string {
+	if capabilitiesIns == nil {
+		return allCapabilitiesKey
+	}
+
+	return capabilitiesIns.String()
+}
+
+func intersect(first capabilities.Capabilities, second capabilities.Capabilities) capabilities.Capabilities {
+	if first == nil {
+		return second
+	}
+
+	if second == nil {
+		return first
+	}
+
+	return first.Intersection(second)
+}
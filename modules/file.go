@@ -1,44 +1,89 @@
 package modules
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"math"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/juju/fslock"
 	"github.com/steve-care-software/interpreter/domain/programs/modules"
+	"github.com/steve-care-software/rodan/capabilities"
 )
 
+const fileLockRetryInterval = 10 * time.Millisecond
+const fileKeyPermissions = 0600
+const fileCreatePermissions = 0644
+
 type file struct {
-	absBasePath string
-	chunkSize   uint
+	absBasePath   string
+	chunkSize     uint
+	meter         *meter
+	roots         map[string]string
+	encryptionKey []byte
 }
 
+// createFile creates the file modules, sealing the files they write and opening the files they read with AES-GCM when an encryption key is provided
 func createFile(
 	absBasePath string,
 	chunkSize uint,
+	meter *meter,
+	capabilitiesIns capabilities.Capabilities,
+	encryptionKey []byte,
 ) *file {
+	roots := map[string]string{}
+	names := []string{
+		capabilities.FileRead,
+		capabilities.FileWrite,
+		capabilities.FileLock,
+	}
+
+	for _, oneName := range names {
+		if capabilitiesIns == nil {
+			roots[oneName] = absBasePath
+			continue
+		}
+
+		capability, err := capabilitiesIns.Fetch(oneName)
+		if err != nil {
+			continue
+		}
+
+		roots[oneName] = absBasePath
+		if capability.HasPath() {
+			roots[oneName] = filepath.Join(absBasePath, capability.Path())
+		}
+	}
+
 	out := file{
-		absBasePath: absBasePath,
-		chunkSize:   chunkSize,
+		absBasePath:   absBasePath,
+		chunkSize:     chunkSize,
+		meter:         meter,
+		roots:         roots,
+		encryptionKey: encryptionKey,
 	}
 
 	return &out
 }
 
 // Execute executes the application
-func (app *file) Execute() map[uint]modules.ExecuteFn {
+func (app *file) Execute() map[uint]modules.ExecuteContextFn {
 	fileOpen := app.fileOpen()
+	fileCreate := app.fileCreate()
 	fileClose := app.fileClose()
 	fileLock := app.fileLock()
 	fileUnLock := app.fileUnLock()
 	fileInfo := app.fileInfo()
 	fileRead := app.fileRead()
 	fileWrite := app.fileWrite()
-	return map[uint]modules.ExecuteFn{
+	return map[uint]modules.ExecuteContextFn{
 		ModuleFileOpen:   fileOpen,
+		ModuleFileCreate: fileCreate,
 		ModuleFileClose:  fileClose,
 		ModuleFileLock:   fileLock,
 		ModuleFileUnLock: fileUnLock,
@@ -48,30 +93,83 @@ func (app *file) Execute() map[uint]modules.ExecuteFn {
 	}
 }
 
-func (app *file) formPath(relativePath string, inputIndex uint) (string, error) {
-	path := filepath.Join(app.absBasePath, relativePath)
+func (app *file) formPath(ctx context.Context, relativePath string, inputIndex uint) (string, error) {
+	root := filepath.Join(app.absBasePath, sandboxFromContext(ctx).path)
+	path := filepath.Join(root, relativePath)
 	absPath, err := filepath.Abs(path)
 	if err != nil {
 		return "", err
 	}
 
-	if strings.HasPrefix(absPath, app.absBasePath) {
+	if isWithin(absPath, root) {
 		return path, nil
 	}
 
-	str := fmt.Sprintf("the input at index (%d) was expected to contain a relative path (%s) that was expected to not seek before the base directory (%s)", inputIndex, relativePath, app.absBasePath)
+	str := fmt.Sprintf("the input at index (%d) was expected to contain a relative path (%s) that was expected to not seek before the base directory (%s)", inputIndex, relativePath, root)
 	return "", errors.New(str)
 }
 
-func (app *file) fileOpen() modules.ExecuteFn {
-	return func(input map[uint]interface{}) (interface{}, error) {
+func (app *file) authorize(ctx context.Context, path string, isWrite bool, names ...string) error {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+
+	sandboxIns := sandboxFromContext(ctx)
+	if isWrite && sandboxIns.isReadOnly {
+		str := fmt.Sprintf("the path (%s) cannot be written to because the sandbox is read-only", path)
+		return errors.New(str)
+	}
+
+	if !isWithin(absPath, filepath.Join(app.absBasePath, sandboxIns.path)) {
+		str := fmt.Sprintf("the path (%s) is outside of the sandbox (%s)", path, sandboxIns.path)
+		return errors.New(str)
+	}
+
+	for _, oneName := range names {
+		if root, ok := app.roots[oneName]; ok && isWithin(absPath, root) {
+			return nil
+		}
+	}
+
+	str := fmt.Sprintf("the path (%s) is denied by the capabilities (%s)", path, strings.Join(names, ", "))
+	return errors.New(str)
+}
+
+func isWithin(path string, root string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return false
+	}
+
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func (app *file) fileOpen() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
 		if relativePath, ok := input[0].([]byte); ok {
-			path, err := app.formPath(strings.TrimSpace(string(relativePath)), 0)
+			path, err := app.formPath(ctx, strings.TrimSpace(string(relativePath)), 0)
+			if err != nil {
+				return nil, err
+			}
+
+			err = app.authorize(ctx, path, false, capabilities.FileRead, capabilities.FileWrite)
 			if err != nil {
 				return nil, err
 			}
 
-			return os.Open(path)
+			err = meterFromContext(ctx, app.meter).open()
+			if err != nil {
+				return nil, err
+			}
+
+			pConn, err := os.Open(path)
+			if err != nil {
+				meterFromContext(ctx, app.meter).close()
+				return nil, err
+			}
+
+			return pConn, nil
 		}
 
 		str := fmt.Sprintf("the input at index (%d) was expected to contain a string", 0)
@@ -79,14 +177,48 @@ func (app *file) fileOpen() modules.ExecuteFn {
 	}
 }
 
-func (app *file) fileClose() modules.ExecuteFn {
-	return func(input map[uint]interface{}) (interface{}, error) {
+// fileCreate opens a file for reading and writing, creating it if it does not exist
+func (app *file) fileCreate() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
+		if relativePath, ok := input[0].([]byte); ok {
+			path, err := app.formPath(ctx, strings.TrimSpace(string(relativePath)), 0)
+			if err != nil {
+				return nil, err
+			}
+
+			err = app.authorize(ctx, path, true, capabilities.FileWrite)
+			if err != nil {
+				return nil, err
+			}
+
+			err = meterFromContext(ctx, app.meter).open()
+			if err != nil {
+				return nil, err
+			}
+
+			pConn, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, fileCreatePermissions)
+			if err != nil {
+				meterFromContext(ctx, app.meter).close()
+				return nil, err
+			}
+
+			return pConn, nil
+		}
+
+		str := fmt.Sprintf("the input at index (%d) was expected to contain a string", 0)
+		return nil, errors.New(str)
+	}
+}
+
+func (app *file) fileClose() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
 		if pConn, ok := input[0].(*os.File); ok {
 			err := pConn.Close()
 			if err != nil {
 				return nil, err
 			}
 
+			meterFromContext(ctx, app.meter).close()
 			return nil, nil
 		}
 
@@ -95,17 +227,33 @@ func (app *file) fileClose() modules.ExecuteFn {
 	}
 }
 
-func (app *file) fileLock() modules.ExecuteFn {
-	return func(input map[uint]interface{}) (interface{}, error) {
+func (app *file) fileLock() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
 		if relativePath, ok := input[0].(string); ok {
-			path, err := app.formPath(relativePath, 0)
+			path, err := app.formPath(ctx, relativePath, 0)
 			if err != nil {
 				return nil, err
 			}
 
+			err = app.authorize(ctx, path, true, capabilities.FileLock)
+			if err != nil {
+				return nil, err
+			}
+
+			err = meterFromContext(ctx, app.meter).open()
+			if err != nil {
+				return nil, err
+			}
+
+			wait := false
+			if isWait, ok := input[1].(bool); ok {
+				wait = isWait
+			}
+
 			pLock := fslock.New(path)
-			err = pLock.TryLock()
+			err = app.lock(ctx, pLock, wait)
 			if err != nil {
+				meterFromContext(ctx, app.meter).close()
 				return nil, err
 			}
 
@@ -117,14 +265,34 @@ func (app *file) fileLock() modules.ExecuteFn {
 	}
 }
 
-func (app *file) fileUnLock() modules.ExecuteFn {
-	return func(input map[uint]interface{}) (interface{}, error) {
+func (app *file) lock(ctx context.Context, pLock *fslock.Lock, wait bool) error {
+	for {
+		err := pLock.TryLock()
+		if err == nil {
+			return nil
+		}
+
+		if !wait || err != fslock.ErrLocked {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(fileLockRetryInterval):
+		}
+	}
+}
+
+func (app *file) fileUnLock() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
 		if pLock, ok := input[0].(*fslock.Lock); ok {
 			err := pLock.Unlock()
 			if err != nil {
 				return nil, err
 			}
 
+			meterFromContext(ctx, app.meter).close()
 			return nil, nil
 		}
 
@@ -133,8 +301,8 @@ func (app *file) fileUnLock() modules.ExecuteFn {
 	}
 }
 
-func (app *file) fileInfo() modules.ExecuteFn {
-	return func(input map[uint]interface{}) (interface{}, error) {
+func (app *file) fileInfo() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
 		if pConn, ok := input[0].(*os.File); ok {
 			return pConn.Stat()
 		}
@@ -144,8 +312,8 @@ func (app *file) fileInfo() modules.ExecuteFn {
 	}
 }
 
-func (app *file) fileRead() modules.ExecuteFn {
-	return func(input map[uint]interface{}) (interface{}, error) {
+func (app *file) fileRead() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
 		index := uint(0)
 		if idx, ok := input[2].(uint); ok {
 			index = idx
@@ -153,30 +321,80 @@ func (app *file) fileRead() modules.ExecuteFn {
 
 		sizeInBytes := int64(-1)
 		if amount, ok := input[1].(uint); ok {
+			if uint64(amount) > math.MaxInt64 {
+				str := fmt.Sprintf("the input at index (%d) was expected to contain an amount of bytes (%d) that does not exceed %d", 1, amount, int64(math.MaxInt64))
+				return nil, errors.New(str)
+			}
+
 			sizeInBytes = int64(amount)
 		}
 
 		if pConn, ok := input[0].(*os.File); ok {
-			if sizeInBytes == -1 {
-				pInfo, err := pConn.Stat()
-				if err != nil {
-					return nil, err
-				}
+			// the encrypted files are sealed as a whole, so they can only be read as a whole:
+			if app.encryptionKey != nil && (index != 0 || sizeInBytes != -1) {
+				str := fmt.Sprintf("the file (%s) is encrypted and therefore can only be read as a whole", pConn.Name())
+				return nil, errors.New(str)
+			}
+
+			pInfo, err := pConn.Stat()
+			if err != nil {
+				return nil, err
+			}
 
-				sizeInBytes = pInfo.Size()
+			// the amount is clamped to the bytes following the index, so that it never allocates more than the file contains:
+			remaining := int64(0)
+			if uint64(index) < uint64(pInfo.Size()) {
+				remaining = pInfo.Size() - int64(index)
 			}
 
-			data := make([]byte, sizeInBytes)
-			readAmount, err := pConn.ReadAt(data, int64(index))
+			if sizeInBytes == -1 || sizeInBytes > remaining {
+				sizeInBytes = remaining
+			}
+
+			err = app.authorize(ctx, pConn.Name(), false, capabilities.FileRead)
 			if err != nil {
 				return nil, err
 			}
 
-			if int64(readAmount) != sizeInBytes {
+			err = meterFromContext(ctx, app.meter).read(uint(sizeInBytes))
+			if err != nil {
+				return nil, err
+			}
+
+			chunkSize := int64(app.chunkSize)
+			if chunkSize <= 0 {
+				chunkSize = sizeInBytes
+			}
+
+			data := make([]byte, sizeInBytes)
+			readAmount := int64(0)
+			for readAmount < sizeInBytes {
+				err := ctx.Err()
+				if err != nil {
+					return nil, err
+				}
+
+				end := readAmount + chunkSize
+				if end > sizeInBytes {
+					end = sizeInBytes
+				}
+
+				amount, err := pConn.ReadAt(data[readAmount:end], int64(index)+readAmount)
+				readAmount += int64(amount)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			if readAmount != sizeInBytes {
 				str := fmt.Sprintf("%d bytes were expected to be read, %d actually read", sizeInBytes, readAmount)
 				return nil, errors.New(str)
 			}
 
+			if app.encryptionKey != nil {
+				return openAES(app.encryptionKey, data)
+			}
+
 			return data, nil
 		}
 
@@ -185,8 +403,8 @@ func (app *file) fileRead() modules.ExecuteFn {
 	}
 }
 
-func (app *file) fileWrite() modules.ExecuteFn {
-	return func(input map[uint]interface{}) (interface{}, error) {
+func (app *file) fileWrite() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
 		index := uint(0)
 		if idx, ok := input[2].(uint); ok {
 			index = idx
@@ -194,6 +412,20 @@ func (app *file) fileWrite() modules.ExecuteFn {
 
 		if pConn, ok := input[0].(*os.File); ok {
 			if data, ok := input[1].([]byte); ok {
+				err := app.authorize(ctx, pConn.Name(), true, capabilities.FileWrite)
+				if err != nil {
+					return nil, err
+				}
+
+				if app.encryptionKey != nil {
+					return app.writeSealed(ctx, pConn, data, index)
+				}
+
+				err = meterFromContext(ctx, app.meter).write(uint(len(data)))
+				if err != nil {
+					return nil, err
+				}
+
 				return pConn.WriteAt(data, int64(index))
 			}
 
@@ -205,3 +437,107 @@ func (app *file) fileWrite() modules.ExecuteFn {
 		return nil, errors.New(str)
 	}
 }
+
+// writeSealed replaces the content of an encrypted file by the sealed data, returning the amount of data bytes written
+func (app *file) writeSealed(ctx context.Context, pConn *os.File, data []byte, index uint) (int, error) {
+	if index != 0 {
+		str := fmt.Sprintf("the file (%s) is encrypted and therefore can only be written as a whole", pConn.Name())
+		return 0, errors.New(str)
+	}
+
+	sealed, err := sealAES(app.encryptionKey, data)
+	if err != nil {
+		return 0, err
+	}
+
+	err = meterFromContext(ctx, app.meter).write(uint(len(sealed)))
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = pConn.WriteAt(sealed, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	err = pConn.Truncate(int64(len(sealed)))
+	if err != nil {
+		return 0, err
+	}
+
+	return len(data), nil
+}
+
+// writeKey creates the file of a key, readable by its owner only, refusing to replace an existing key
+func (app *file) writeKey(ctx context.Context, relativePath string, key []byte) error {
+	path, err := app.formPath(ctx, relativePath, 0)
+	if err != nil {
+		return err
+	}
+
+	err = app.authorize(ctx, path, true, capabilities.FileWrite)
+	if err != nil {
+		return err
+	}
+
+	err = meterFromContext(ctx, app.meter).write(uint(len(key)))
+	if err != nil {
+		return err
+	}
+
+	// the keys are encrypted at rest as the other files:
+	if app.encryptionKey != nil {
+		key, err = sealAES(app.encryptionKey, key)
+		if err != nil {
+			return err
+		}
+	}
+
+	pConn, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, fileKeyPermissions)
+	if err != nil {
+		return err
+	}
+
+	_, err = pConn.Write(key)
+	if err != nil {
+		pConn.Close()
+		os.Remove(path)
+		return err
+	}
+
+	return pConn.Close()
+}
+
+// readKey reads the file of a key
+func (app *file) readKey(ctx context.Context, relativePath string) ([]byte, error) {
+	path, err := app.formPath(ctx, relativePath, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	err = app.authorize(ctx, path, false, capabilities.FileRead)
+	if err != nil {
+		return nil, err
+	}
+
+	pInfo, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = meterFromContext(ctx, app.meter).read(uint(pInfo.Size()))
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if app.encryptionKey != nil {
+		return openAES(app.encryptionKey, key)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,109 @@
+package modules
+
+import (
+	"context"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFile_read_amountPastEnd_Success(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	err = ioutil.WriteFile(filepath.Join(basePath, "data.txt"), []byte("0123456789"), 0644)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	fns := createFile(basePath, 1024, createMeter(nil), nil, nil).Execute()
+	pConn, err := fns[ModuleFileOpen](context.Background(), map[uint]interface{}{
+		0: []byte("data.txt"),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer fns[ModuleFileClose](context.Background(), map[uint]interface{}{
+		0: pConn,
+	})
+
+	data, err := fns[ModuleFileRead](context.Background(), map[uint]interface{}{
+		0: pConn,
+		1: uint(math.MaxInt64),
+		2: uint(6),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if string(data.([]byte)) != "6789" {
+		t.Errorf("the data was expected to be '%s', '%s' returned", "6789", data)
+		return
+	}
+
+	data, err = fns[ModuleFileRead](context.Background(), map[uint]interface{}{
+		0: pConn,
+		2: uint(20),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(data.([]byte)) != 0 {
+		t.Errorf("the data was expected to be empty, '%s' returned", data)
+		return
+	}
+}
+
+func TestFile_read_amountExceedsMaxInt64_returnsError(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	err = ioutil.WriteFile(filepath.Join(basePath, "data.txt"), []byte("0123456789"), 0644)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	fns := createFile(basePath, 1024, createMeter(nil), nil, nil).Execute()
+	pConn, err := fns[ModuleFileOpen](context.Background(), map[uint]interface{}{
+		0: []byte("data.txt"),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer fns[ModuleFileClose](context.Background(), map[uint]interface{}{
+		0: pConn,
+	})
+
+	_, err = fns[ModuleFileRead](context.Background(), map[uint]interface{}{
+		0: pConn,
+		1: uint(math.MaxUint64),
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
@@ -0,0 +1,61 @@
+package modules
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/steve-care-software/interpreter/domain/programs/modules"
+	"github.com/steve-care-software/rodan/capabilities"
+)
+
+type grantedModules struct {
+	list         []modules.Module
+	mp           map[uint]modules.Module
+	capabilities capabilities.Capabilities
+}
+
+func createGrantedModules(
+	modulesIns modules.Modules,
+	capabilities capabilities.Capabilities,
+) modules.Modules {
+	list := []modules.Module{}
+	mp := map[uint]modules.Module{}
+	for _, oneCapability := range capabilities.List() {
+		for _, oneIndex := range moduleGroups[oneCapability.Name()] {
+			if _, ok := mp[oneIndex]; ok {
+				continue
+			}
+
+			module, err := modulesIns.Fetch(oneIndex)
+			if err != nil {
+				continue
+			}
+
+			list = append(list, module)
+			mp[oneIndex] = module
+		}
+	}
+
+	out := grantedModules{
+		list:         list,
+		mp:           mp,
+		capabilities: capabilities,
+	}
+
+	return &out
+}
+
+// List returns the granted modules
+func (obj *grantedModules) List() []modules.Module {
+	return obj.list
+}
+
+// Fetch fetches a granted module by index
+func (obj *grantedModules) Fetch(index uint) (modules.Module, error) {
+	if ins, ok := obj.mp[index]; ok {
+		return ins, nil
+	}
+
+	str := fmt.Sprintf("the module (index: %d) is denied by the capabilities (%s)", index, obj.capabilities.String())
+	return nil, errors.New(str)
+}
@@ -0,0 +1,107 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	interpreter_applications "github.com/steve-care-software/interpreter/applications"
+	"github.com/steve-care-software/interpreter/domain/programs"
+	"github.com/steve-care-software/rodan/capabilities"
+)
+
+type imported struct {
+	program programs.Program
+	modTime time.Time
+}
+
+// importFn returns the func compiling the scripts imported by a script granted the provided capabilities,
+// the chain containing the absolute paths of the scripts currently being imported
+func (app *environment) importFn(capabilitiesIns capabilities.Capabilities, chain []string) interpreter_applications.ImportFn {
+	fileIns := createFile(app.absBasePath, app.chunkSize, app.meter, capabilitiesIns, nil)
+	return func(ctx context.Context, path []byte) (programs.Program, error) {
+		// the imported scripts are read like files, within the sandbox and the file.read capability:
+		relativePath, err := fileIns.formPath(ctx, string(path), 0)
+		if err != nil {
+			return nil, err
+		}
+
+		err = fileIns.authorize(ctx, relativePath, false, capabilities.FileRead)
+		if err != nil {
+			return nil, err
+		}
+
+		absPath, err := filepath.Abs(relativePath)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, oneImporting := range chain {
+			if oneImporting == absPath {
+				str := fmt.Sprintf("the script (path: %s) imports itself through the chain: %s", path, strings.Join(append(chain, absPath), " -> "))
+				return nil, errors.New(str)
+			}
+		}
+
+		info, err := os.Stat(absPath)
+		if err != nil {
+			return nil, err
+		}
+
+		// the script is metered even when cached, so that the limits do not depend on the cache:
+		err = meterFromContext(ctx, app.meter).read(uint(info.Size()))
+		if err != nil {
+			return nil, err
+		}
+
+		key := fmt.Sprintf("%s:%s", capabilitiesKey(capabilitiesIns), absPath)
+		app.importMutex.Lock()
+		cached, ok := app.imports[key]
+		app.importMutex.Unlock()
+		if ok && cached.modTime.Equal(info.ModTime()) {
+			return cached.program, nil
+		}
+
+		script, err := ioutil.ReadFile(absPath)
+		if err != nil {
+			return nil, err
+		}
+
+		// the imported script is granted the capabilities of its importer, restricted by its own header:
+		restricted, err := app.restrict(capabilitiesIns, script)
+		if err != nil {
+			return nil, err
+		}
+
+		importChain := append(append([]string{}, chain...), absPath)
+		vmApp := newApplication(app.grammar, app.query, app.fetchModulesFn(restricted), app.importFn(restricted, importChain))
+		tree, err := vmApp.Lex(script)
+		if err != nil {
+			return nil, err
+		}
+
+		if tree.HasRemaining() {
+			str := fmt.Sprintf("the script (path: %s) contains data that could not be lexed", path)
+			return nil, errors.New(str)
+		}
+
+		program, _, err := vmApp.ParseContext(ctx, tree)
+		if err != nil {
+			return nil, err
+		}
+
+		app.importMutex.Lock()
+		app.imports[key] = &imported{
+			program: program,
+			modTime: info.ModTime(),
+		}
+		app.importMutex.Unlock()
+
+		return program, nil
+	}
+}
@@ -0,0 +1,361 @@
+package modules
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steve-care-software/rodan/capabilities"
+	"github.com/steve-care-software/rodan/limits"
+)
+
+func writeScripts(basePath string, scripts map[string]string) error {
+	for path, script := range scripts {
+		absPath := filepath.Join(basePath, path)
+		err := os.MkdirAll(filepath.Dir(absPath), 0755)
+		if err != nil {
+			return err
+		}
+
+		err = ioutil.WriteFile(absPath, []byte(script), 0644)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestImport_Success(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	err = writeScripts(basePath, map[string]string{
+		"lib/greeting.rodan": `
+			<- $output;;
+			$output = hello;;
+		`,
+		"lib/identity.rodan": `
+			-> $value;;
+			<- $output;;
+			$output = $value;;
+		`,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(basePath).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		import "lib/greeting.rodan" as $greeting;;
+		import "lib/identity.rodan" as $identity;;
+
+		-> $input;;
+		<- $first;;
+		<- $second;;
+
+		$first = $greeting;;
+		attach $input:0 $identity;;
+		$second = execute $identity;;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	output, err := application.Interpret([]interface{}{"input"}, program)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(output) != 2 {
+		t.Errorf("%d output was expected, %d returned", 2, len(output))
+		return
+	}
+
+	if string(output[0].([]byte)) != " hello" {
+		t.Errorf("the first output was expected to be '%s', '%s' returned", " hello", output[0])
+		return
+	}
+
+	if output[1].(string) != "input" {
+		t.Errorf("the second output was expected to be '%s', '%s' returned", "input", output[1])
+		return
+	}
+}
+
+func TestImport_isCached_Success(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	err = writeScripts(basePath, map[string]string{
+		"identity.rodan": `
+			-> $value;;
+			<- $output;;
+			$output = $value;;
+		`,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	importFn := newEnvironment(basePath, 1024, createMeter(nil), nil).importFn(nil, nil)
+	first, err := importFn(context.Background(), []byte("identity.rodan"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	second, err := importFn(context.Background(), []byte("./identity.rodan"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if first != second {
+		t.Errorf("the imported program was expected to be compiled once and then cached")
+		return
+	}
+}
+
+func TestImport_withCycle_returnsError(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	err = writeScripts(basePath, map[string]string{
+		"first.rodan": `
+			import "second.rodan" as $second;;
+		`,
+		"second.rodan": `
+			import "first.rodan" as $first;;
+		`,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(basePath).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	treeIns, err := application.Lex([]byte(`import "first.rodan" as $first;;`))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, _, err = application.Parse(treeIns)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	if !strings.Contains(err.Error(), "imports itself") {
+		t.Errorf("the error was expected to describe the cycle, returned: %s", err.Error())
+		return
+	}
+}
+
+func TestImport_outsideBasePath_returnsError(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(basePath).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	treeIns, err := application.Lex([]byte(`import "../outside.rodan" as $outside;;`))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, _, err = application.Parse(treeIns)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestImport_outsideSandbox_returnsError(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	identity := `
+		-> $value;;
+		<- $output;;
+		$output = $value;;
+	`
+
+	err = writeScripts(basePath, map[string]string{
+		"identity.rodan":       identity,
+		"child/identity.rodan": identity,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	ctx, err := withSandbox(context.Background(), "child", true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	importFn := newEnvironment(basePath, 1024, createMeter(nil), nil).importFn(nil, nil)
+	_, err = importFn(ctx, []byte("identity.rodan"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = importFn(ctx, []byte("../identity.rodan"))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestImport_withoutFileRead_returnsError(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	err = writeScripts(basePath, map[string]string{
+		"identity.rodan": `
+			-> $value;;
+			<- $output;;
+			$output = $value;;
+		`,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	capabilitiesIns, err := capabilities.NewAdapter().ToCapabilities([]byte("file.write"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	importFn := newEnvironment(basePath, 1024, createMeter(nil), nil).importFn(capabilitiesIns, nil)
+	_, err = importFn(context.Background(), []byte("identity.rodan"))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestImport_readBytes_returnsError(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	err = writeScripts(basePath, map[string]string{
+		"identity.rodan": `
+			-> $value;;
+			<- $output;;
+			$output = $value;;
+		`,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	limitsIns, _ := limits.NewBuilder().Create().
+		WithReadBytes(4).
+		Now()
+
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(basePath).
+		WithChunkSize(1024).
+		WithLimits(limitsIns).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	treeIns, err := application.Lex([]byte(`import "identity.rodan" as $identity;;`))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, _, err = application.Parse(treeIns)
+	if err == nil || !strings.Contains(err.Error(), limits.ErrReadBytesExceeded.Error()) {
+		t.Errorf("the error was expected to contain ErrReadBytesExceeded, returned: %v", err)
+		return
+	}
+}
@@ -0,0 +1,318 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	interpreter_applications "github.com/steve-care-software/interpreter/applications"
+	"github.com/steve-care-software/interpreter/domain/programs"
+	"github.com/steve-care-software/rodan/limits"
+	vm_applications "github.com/steve-care-software/vm/applications"
+)
+
+func TestLimits_instructions_returnsError(t *testing.T) {
+	limitsIns, err := limits.NewBuilder().Create().WithInstructions(10).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		WithLimits(limitsIns).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// the loop only contains assignments, without any module call:
+	script := `
+		-> $values;;
+		<- $last;;
+
+		for $value in $values {
+			$name = "value";;
+			$last = $value;;
+		};;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	values := []interface{}{}
+	for i := 0; i < 3; i++ {
+		values = append(values, uint(i))
+	}
+
+	_, err = application.Interpret([]interface{}{values}, program)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	for i := 3; i < 100; i++ {
+		values = append(values, uint(i))
+	}
+
+	_, err = application.Interpret([]interface{}{values}, program)
+	if !errors.Is(err, limits.ErrInstructionsExceeded) {
+		t.Errorf("the error was expected to be ErrInstructionsExceeded, returned: %v", err)
+		return
+	}
+}
+
+func TestLimits_depth_returnsError(t *testing.T) {
+	limitsIns, _ := limits.NewBuilder().Create().WithDepth(1).Now()
+	meter := createMeter(limitsIns).start()
+
+	err := meter.enter()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = meter.enter()
+	if !errors.Is(err, limits.ErrDepthExceeded) {
+		t.Errorf("the error was expected to be ErrDepthExceeded, returned: %v", err)
+		return
+	}
+
+	meter.leave()
+	err = meter.enter()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+}
+
+func TestLimits_deadline_returnsError(t *testing.T) {
+	limitsIns, _ := limits.NewBuilder().Create().WithDeadline(time.Millisecond).Now()
+	meter := createMeter(limitsIns).start()
+
+	time.Sleep(5 * time.Millisecond)
+	err := meter.instruction()
+	if !errors.Is(err, limits.ErrDeadlineExceeded) {
+		t.Errorf("the error was expected to be ErrDeadlineExceeded, returned: %v", err)
+		return
+	}
+}
+
+func TestLimits_files_returnsError(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	err = ioutil.WriteFile(filepath.Join(basePath, "data.txt"), []byte("0123456789"), 0644)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	limitsIns, _ := limits.NewBuilder().Create().
+		WithHandles(1).
+		WithReadBytes(4).
+		WithWrittenBytes(2).
+		Now()
+
+	meter := createMeter(limitsIns).start()
+
+	fns := createFile(basePath, 1024, meter, nil, nil).Execute()
+	pConn, err := fns[ModuleFileOpen](context.Background(), map[uint]interface{}{
+		0: []byte("data.txt"),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = fns[ModuleFileOpen](context.Background(), map[uint]interface{}{
+		0: []byte("data.txt"),
+	})
+
+	if !errors.Is(err, limits.ErrHandlesExceeded) {
+		t.Errorf("the error was expected to be ErrHandlesExceeded, returned: %v", err)
+		return
+	}
+
+	_, err = fns[ModuleFileRead](context.Background(), map[uint]interface{}{
+		0: pConn,
+	})
+
+	if !errors.Is(err, limits.ErrReadBytesExceeded) {
+		t.Errorf("the error was expected to be ErrReadBytesExceeded, returned: %v", err)
+		return
+	}
+
+	_, err = fns[ModuleFileWrite](context.Background(), map[uint]interface{}{
+		0: pConn,
+		1: []byte("abc"),
+	})
+
+	if !errors.Is(err, limits.ErrWrittenBytesExceeded) {
+		t.Errorf("the error was expected to be ErrWrittenBytesExceeded, returned: %v", err)
+		return
+	}
+
+	_, err = fns[ModuleFileClose](context.Background(), map[uint]interface{}{
+		0: pConn,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = fns[ModuleFileOpen](context.Background(), map[uint]interface{}{
+		0: []byte("data.txt"),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+}
+
+func TestLimits_depth_withoutLimits_returnsError(t *testing.T) {
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// the script interprets itself, forever:
+	script := `
+		module @list:0;;
+		module @lexParseThenInterpret:34;;
+
+		-> $script;;
+		<- $output;;
+
+		$input = @list($script);;
+		$output = @lexParseThenInterpret($script, $input);;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = application.Interpret([]interface{}{[]byte(script)}, program)
+	if !errors.Is(err, limits.ErrDepthExceeded) {
+		t.Errorf("the error was expected to be ErrDepthExceeded, returned: %v", err)
+		return
+	}
+}
+
+type nestedInterpretTracer struct {
+	application vm_applications.Application
+	program     programs.Program
+	isDone      bool
+	err         error
+}
+
+// Trace interprets the nested program once, after the first instruction of the outer one
+func (app *nestedInterpretTracer) Trace(ctx context.Context, event interpreter_applications.Event) error {
+	if app.isDone {
+		return nil
+	}
+
+	app.isDone = true
+	_, app.err = app.application.Interpret([]interface{}{}, app.program)
+	return nil
+}
+
+func TestLimits_files_perInterpret_Success(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	err = ioutil.WriteFile(filepath.Join(basePath, "data.txt"), []byte("0123456789"), 0644)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	limitsIns, _ := limits.NewBuilder().Create().WithHandles(1).Now()
+	tracer := nestedInterpretTracer{}
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(basePath).
+		WithChunkSize(1024).
+		WithLimits(limitsIns).
+		WithTracer(&tracer).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// the nested interpretation leaves its handle open, which must not count against the outer one:
+	script := `
+		module @fileOpen:2;;
+
+		<- $output;;
+
+		$path = "data.txt";;
+		$output = @fileOpen($path);;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	tracer.application = application
+	tracer.program = program
+	_, err = application.Interpret([]interface{}{}, program)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !tracer.isDone || tracer.err != nil {
+		t.Errorf("the nested interpretation was expected to succeed, returned: %v", tracer.err)
+		return
+	}
+}
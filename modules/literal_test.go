@@ -0,0 +1,77 @@
+package modules
+
+import (
+	"os"
+	"testing"
+
+	"github.com/steve-care-software/ast/domain/grammars/cardinalities"
+	"github.com/steve-care-software/ast/domain/grammars/values"
+)
+
+func TestLiteral_Success(t *testing.T) {
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		module @cardinality:15;;
+		module @value:14;;
+
+		<- $cardinality;;
+		<- $value;;
+
+		$min = 1;;
+		$max = 3;;
+		$cardinality = @cardinality($min, $max);;
+
+		$number = 59;;
+		$name = "semicolon: \x3b";;
+		$value = @value($number, $name);;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	output, err := application.Interpret([]interface{}{}, program)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(output) != 2 {
+		t.Errorf("%d output was expected, %d returned", 2, len(output))
+		return
+	}
+
+	cardinality := output[0].(cardinalities.Cardinality)
+	if cardinality.Min() != 1 || !cardinality.HasMax() || *cardinality.Max() != 3 {
+		t.Errorf("the cardinality was expected to be [1,3]")
+		return
+	}
+
+	value := output[1].(values.Value)
+	if value.Number() != ';' {
+		t.Errorf("the value's number was expected to be %d, %d returned", ';', value.Number())
+		return
+	}
+
+	if value.Name() != "semicolon: ;" {
+		t.Errorf("the value's name was expected to be '%s', '%s' returned", "semicolon: ;", value.Name())
+		return
+	}
+}
@@ -0,0 +1,129 @@
+package modules
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/steve-care-software/interpreter/domain/programs/modules"
+)
+
+type logic struct {
+}
+
+func createLogic() *logic {
+	out := logic{}
+	return &out
+}
+
+// Execute executes the application
+func (app *logic) Execute() map[uint]modules.ExecuteFn {
+	eq := app.eq()
+	lt := app.ordering(-1)
+	gt := app.ordering(1)
+	and := app.and()
+	or := app.or()
+	not := app.not()
+	return map[uint]modules.ExecuteFn{
+		ModuleEq:  eq,
+		ModuleLt:  lt,
+		ModuleGt:  gt,
+		ModuleAnd: and,
+		ModuleOr:  or,
+		ModuleNot: not,
+	}
+}
+
+// eq compares the numbers by their value whatever their types, then every other value by its content
+func (app *logic) eq() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		first, err := inputValue(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		second, err := inputValue(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		return isEqual(first, second), nil
+	}
+}
+
+// ordering returns a module comparing two numbers, or two texts by their bytes, returning true when the first compares to the second as the sign
+func (app *logic) ordering(sign int) modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		firstNumber, firstErr := inputNumber(input, 0)
+		secondNumber, secondErr := inputNumber(input, 1)
+		if firstErr == nil && secondErr == nil {
+			comparison, err := compareNumbers(firstNumber, secondNumber)
+			if err != nil {
+				return nil, err
+			}
+
+			return comparison == sign, nil
+		}
+
+		first, isFirstText := toText(input[0])
+		second, isSecondText := toText(input[1])
+		if isFirstText && isSecondText {
+			return bytes.Compare([]byte(first), []byte(second)) == sign, nil
+		}
+
+		str := fmt.Sprintf("the inputs were expected to contain two numbers or two texts, %T and %T provided", input[0], input[1])
+		return nil, errors.New(str)
+	}
+}
+
+func (app *logic) and() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		first, err := inputBool(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		second, err := inputBool(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		return first && second, nil
+	}
+}
+
+func (app *logic) or() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		first, err := inputBool(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		second, err := inputBool(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		return first || second, nil
+	}
+}
+
+func (app *logic) not() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		value, err := inputBool(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		return !value, nil
+	}
+}
+
+func inputBool(input map[uint]interface{}, index uint) (bool, error) {
+	if value, ok := input[index].(bool); ok {
+		return value, nil
+	}
+
+	str := fmt.Sprintf("the input at index %d was expected to contain a bool", index)
+	return false, errors.New(str)
+}
@@ -0,0 +1,67 @@
+package modules
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestLogic_Success(t *testing.T) {
+	testCases := []struct {
+		name     string
+		module   uint
+		input    map[uint]interface{}
+		expected interface{}
+		isError  bool
+	}{
+		{name: "eq, same type", module: ModuleEq, input: map[uint]interface{}{0: uint(3), 1: uint(3)}, expected: true},
+		{name: "eq, across types", module: ModuleEq, input: map[uint]interface{}{0: uint(3), 1: 3.0}, expected: true},
+		{name: "eq, signed and unsigned", module: ModuleEq, input: map[uint]interface{}{0: int8(-1), 1: uint(math.MaxUint64)}, expected: false},
+		{name: "eq, large integers", module: ModuleEq, input: map[uint]interface{}{0: uint64(math.MaxUint64), 1: uint64(math.MaxUint64 - 1)}, expected: false},
+		{name: "eq, NaN", module: ModuleEq, input: map[uint]interface{}{0: math.NaN(), 1: math.NaN()}, expected: false},
+		{name: "eq, bytes", module: ModuleEq, input: map[uint]interface{}{0: []byte("abc"), 1: []byte("abc")}, expected: true},
+		{name: "eq, lists", module: ModuleEq, input: map[uint]interface{}{0: []interface{}{uint(1)}, 1: []interface{}{uint(2)}}, expected: false},
+		{name: "eq, lists across types", module: ModuleEq, input: map[uint]interface{}{0: []interface{}{uint(1), []byte("a")}, 1: []interface{}{1, []byte("a")}}, expected: true},
+		{name: "eq, maps across types", module: ModuleEq, input: map[uint]interface{}{0: map[string]interface{}{"a": uint(1)}, 1: map[string]interface{}{"a": 1.0}}, expected: true},
+		{name: "eq, number and bytes", module: ModuleEq, input: map[uint]interface{}{0: uint(1), 1: []byte("1")}, expected: false},
+		{name: "eq, missing input", module: ModuleEq, input: map[uint]interface{}{0: uint(1)}, isError: true},
+		{name: "lt, numbers", module: ModuleLt, input: map[uint]interface{}{0: -1, 1: uint(0)}, expected: true},
+		{name: "lt, equal numbers", module: ModuleLt, input: map[uint]interface{}{0: 1.0, 1: uint(1)}, expected: false},
+		{name: "lt, texts", module: ModuleLt, input: map[uint]interface{}{0: []byte("abc"), 1: []byte("abd")}, expected: true},
+		{name: "lt, NaN", module: ModuleLt, input: map[uint]interface{}{0: math.NaN(), 1: 1}, isError: true},
+		{name: "lt, mixed", module: ModuleLt, input: map[uint]interface{}{0: uint(1), 1: []byte("2")}, isError: true},
+		{name: "gt, numbers", module: ModuleGt, input: map[uint]interface{}{0: 2.5, 1: uint(2)}, expected: true},
+		{name: "gt, texts", module: ModuleGt, input: map[uint]interface{}{0: []byte("b"), 1: []byte("abc")}, expected: true},
+		{name: "gt, bools", module: ModuleGt, input: map[uint]interface{}{0: true, 1: false}, isError: true},
+		{name: "and", module: ModuleAnd, input: map[uint]interface{}{0: true, 1: false}, expected: false},
+		{name: "and, true", module: ModuleAnd, input: map[uint]interface{}{0: true, 1: true}, expected: true},
+		{name: "and, not a bool", module: ModuleAnd, input: map[uint]interface{}{0: true, 1: uint(1)}, isError: true},
+		{name: "or", module: ModuleOr, input: map[uint]interface{}{0: true, 1: false}, expected: true},
+		{name: "or, false", module: ModuleOr, input: map[uint]interface{}{0: false, 1: false}, expected: false},
+		{name: "or, not a bool", module: ModuleOr, input: map[uint]interface{}{0: []byte("true"), 1: false}, isError: true},
+		{name: "not", module: ModuleNot, input: map[uint]interface{}{0: false}, expected: true},
+		{name: "not, not a bool", module: ModuleNot, input: map[uint]interface{}{0: uint(0)}, isError: true},
+	}
+
+	fns := createLogic().Execute()
+	for _, oneTestCase := range testCases {
+		output, err := fns[oneTestCase.module](oneTestCase.input)
+		if oneTestCase.isError {
+			if err == nil {
+				t.Errorf("%s: the error was expected to be valid, nil returned", oneTestCase.name)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: the error was expected to be nil, error returned: %s", oneTestCase.name, err.Error())
+			continue
+		}
+
+		if !reflect.DeepEqual(output, oneTestCase.expected) {
+			t.Errorf("%s: the output was expected to be %v, %v returned", oneTestCase.name, oneTestCase.expected, output)
+			continue
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package modules
+
+import (
+	"os"
+	"testing"
+)
+
+func TestLoop_for_Success(t *testing.T) {
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		module @castToBool:11;;
+		@castToBool $toBool;;
+
+		-> $values;;
+		<- $bools;;
+		<- $last;;
+
+		$bools = for $value in $values {
+			<- $bool;;
+			attach $value:0 $toBool;;
+			$bool = execute $toBool;;
+			$last = $value;;
+		};;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	output, err := application.Interpret([]interface{}{
+		[]interface{}{"true", "false", "true"},
+	}, program)
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(output) != 2 {
+		t.Errorf("%d output was expected, %d returned", 2, len(output))
+		return
+	}
+
+	// the outputs are ordered by their first assignment, which happens inside the loop for $last:
+	if output[0].(string) != "true" {
+		t.Errorf("the last value was expected to be '%s', '%s' returned", "true", output[0])
+		return
+	}
+
+	bools := output[1].([]interface{})
+	expected := []bool{true, false, true}
+	if len(bools) != len(expected) {
+		t.Errorf("%d bools were expected, %d returned", len(expected), len(bools))
+		return
+	}
+
+	for idx, oneBool := range bools {
+		if oneBool.(bool) != expected[idx] {
+			t.Errorf("the bool (index: %d) was expected to be %t, %t returned", idx, expected[idx], oneBool)
+			return
+		}
+	}
+}
+
+func TestLoop_for_withNonListValue_returnsError(t *testing.T) {
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		-> $values;;
+		for $value in $values {};;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = application.Interpret([]interface{}{"true"}, program)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestLoop_while_Success(t *testing.T) {
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		module @castToBool:11;;
+		@castToBool $startToBool;;
+		@castToBool $stopToBool;;
+
+		-> $start;;
+		-> $stop;;
+		<- $steps;;
+
+		attach $start:0 $startToBool;;
+		$isRunning = execute $startToBool;;
+
+		$steps = while $isRunning:3 {
+			<- $step;;
+			attach $stop:0 $stopToBool;;
+			$isRunning = execute $stopToBool;;
+			$step = iteration;;
+		};;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expectations := map[[2]string]int{
+		{"true", "false"}:  1,
+		{"false", "false"}: 0,
+	}
+
+	for input, expected := range expectations {
+		output, err := application.Interpret([]interface{}{input[0], input[1]}, program)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		steps := output[0].([]interface{})
+		if len(steps) != expected {
+			t.Errorf("%d steps were expected, %d returned (input: %v)", expected, len(steps), input)
+			return
+		}
+	}
+
+	_, err = application.Interpret([]interface{}{"true", "true"}, program)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestLoop_variableOutsideItsIteration_returnsError(t *testing.T) {
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		-> $values;;
+		<- $output;;
+
+		for $value in $values {
+			$copy = $value;;
+		};;
+
+		$output = $copy;;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, _, err = application.Parse(treeIns)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
@@ -0,0 +1,228 @@
+package modules
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/steve-care-software/interpreter/domain/programs/modules"
+)
+
+type maps struct {
+}
+
+func createMaps() *maps {
+	out := maps{}
+	return &out
+}
+
+// Execute executes the application
+func (app *maps) Execute() map[uint]modules.ExecuteFn {
+	newMap := app.newMap()
+	set := app.mapSet()
+	get := app.mapGet()
+	has := app.mapHas()
+	deleteFn := app.mapDelete()
+	keys := app.mapKeys()
+	values := app.mapValues()
+	merge := app.mapMerge()
+	return map[uint]modules.ExecuteFn{
+		ModuleMap:       newMap,
+		ModuleMapSet:    set,
+		ModuleMapGet:    get,
+		ModuleMapHas:    has,
+		ModuleMapDelete: deleteFn,
+		ModuleMapKeys:   keys,
+		ModuleMapValues: values,
+		ModuleMapMerge:  merge,
+	}
+}
+
+func (app *maps) newMap() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		return map[string]interface{}{}, nil
+	}
+}
+
+func (app *maps) mapSet() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		values, err := inputMap(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := inputKey(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := inputValue(input, 2)
+		if err != nil {
+			return nil, err
+		}
+
+		out := copyMap(values)
+		out[key] = value
+		return out, nil
+	}
+}
+
+func (app *maps) mapGet() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		values, err := inputMap(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := inputKey(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		if value, ok := values[key]; ok {
+			return value, nil
+		}
+
+		str := fmt.Sprintf("the key (%s) could not be found in the map", key)
+		return nil, errors.New(str)
+	}
+}
+
+func (app *maps) mapHas() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		values, err := inputMap(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := inputKey(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		_, ok := values[key]
+		return ok, nil
+	}
+}
+
+func (app *maps) mapDelete() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		values, err := inputMap(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := inputKey(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		out := copyMap(values)
+		delete(out, key)
+		return out, nil
+	}
+}
+
+func (app *maps) mapKeys() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		values, err := inputMap(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		out := []interface{}{}
+		for _, oneKey := range sortedKeys(values) {
+			out = append(out, []byte(oneKey))
+		}
+
+		return out, nil
+	}
+}
+
+func (app *maps) mapValues() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		values, err := inputMap(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		out := []interface{}{}
+		for _, oneKey := range sortedKeys(values) {
+			out = append(out, values[oneKey])
+		}
+
+		return out, nil
+	}
+}
+
+func (app *maps) mapMerge() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		first, err := inputMap(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		second, err := inputMap(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		// the values of the second map replace the values of the first map under the same keys:
+		out := copyMap(first)
+		for oneKey, oneValue := range second {
+			out[oneKey] = oneValue
+		}
+
+		return out, nil
+	}
+}
+
+func inputMap(input map[uint]interface{}, index uint) (map[string]interface{}, error) {
+	if value, ok := input[index].(map[string]interface{}); ok {
+		return value, nil
+	}
+
+	str := fmt.Sprintf("the input at index %d was expected to contain a map", index)
+	return nil, errors.New(str)
+}
+
+func inputKey(input map[uint]interface{}, index uint) (string, error) {
+	if value, ok := toKey(input[index]); ok {
+		return value, nil
+	}
+
+	str := fmt.Sprintf("the input at index %d was expected to contain a key, as bytes", index)
+	return "", errors.New(str)
+}
+
+func toKey(value interface{}) (string, bool) {
+	if casted, ok := value.([]byte); ok {
+		return string(casted), true
+	}
+
+	if casted, ok := value.(string); ok {
+		return casted, true
+	}
+
+	return "", false
+}
+
+func copyMap(values map[string]interface{}) map[string]interface{} {
+	out := map[string]interface{}{}
+	for oneKey, oneValue := range values {
+		out[oneKey] = oneValue
+	}
+
+	return out
+}
+
+// sortedKeys returns the keys of the map, sorted by their bytes, so that the maps are always listed in the same order
+func sortedKeys(values map[string]interface{}) []string {
+	out := []string{}
+	for oneKey := range values {
+		out = append(out, oneKey)
+	}
+
+	sort.Strings(out)
+	return out
+}
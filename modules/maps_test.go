@@ -0,0 +1,85 @@
+package modules
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMaps_Success(t *testing.T) {
+	declarations := `
+		module @map:51;;
+		module @mapSet:52;;
+		module @mapGet:53;;
+		module @mapHas:54;;
+		module @mapDelete:55;;
+		module @mapKeys:56;;
+		module @mapValues:57;;
+		module @mapMerge:58;;
+		module @castToMap:59;;
+		module @castToList:60;;
+
+		<- $output;;
+
+		$name = "name";;
+		$age = "age";;
+		$city = "city";;
+		$one = 1;;
+		$two = 2;;
+		$pairs = [["name", "rodan"], ["age", 3], ["name", "last"]];;
+		$invalidPairs = [["name"]];;
+		$empty = @map();;
+		$named = @mapSet($empty, $name, $one);;
+		$record = @mapSet($named, $age, $two);;
+		$others = @castToMap($pairs);;
+	`
+
+	testCases := []struct {
+		name     string
+		script   string
+		expected interface{}
+		isError  bool
+	}{
+		{name: "map", script: "$output = $empty;;", expected: map[string]interface{}{}},
+		{name: "set", script: "$output = $record;;", expected: map[string]interface{}{"name": uint(1), "age": uint(2)}},
+		{name: "set, not a map", script: "$output = @mapSet($one, $name, $one);;", isError: true},
+		{name: "set, key not bytes", script: "$output = @mapSet($empty, $one, $one);;", isError: true},
+		{name: "get", script: "$output = @mapGet($record, $age);;", expected: uint(2)},
+		{name: "get, missing", script: "$output = @mapGet($record, $city);;", isError: true},
+		{name: "has", script: "$output = @mapHas($record, $name);;", expected: true},
+		{name: "has, missing", script: "$output = @mapHas($record, $city);;", expected: false},
+		{name: "delete", script: "$output = @mapDelete($record, $name);;", expected: map[string]interface{}{"age": uint(2)}},
+		{name: "delete, missing", script: "$output = @mapDelete($record, $city);;", expected: map[string]interface{}{"name": uint(1), "age": uint(2)}},
+		{name: "keys, sorted", script: "$output = @mapKeys($record);;", expected: []interface{}{[]byte("age"), []byte("name")}},
+		{name: "values, sorted by key", script: "$output = @mapValues($record);;", expected: []interface{}{uint(2), uint(1)}},
+		{name: "merge", script: "$output = @mapMerge($record, $others);;", expected: map[string]interface{}{"name": []byte("last"), "age": uint(3)}},
+		{name: "castToMap", script: "$output = $others;;", expected: map[string]interface{}{"name": []byte("last"), "age": uint(3)}},
+		{name: "castToMap, invalid pair", script: "$output = @castToMap($invalidPairs);;", isError: true},
+		{name: "castToList", script: "$output = @castToList($record);;", expected: []interface{}{
+			[]interface{}{[]byte("age"), uint(2)},
+			[]interface{}{[]byte("name"), uint(1)},
+		}},
+		{name: "castToList, not a map", script: "$output = @castToList($pairs);;", isError: true},
+		{name: "round trip", script: "$list = @castToList($record);;\n$output = @castToMap($list);;", expected: map[string]interface{}{"name": uint(1), "age": uint(2)}},
+	}
+
+	for _, oneTestCase := range testCases {
+		output, err := executeContainersScript(declarations + oneTestCase.script)
+		if oneTestCase.isError {
+			if err == nil {
+				t.Errorf("%s: the error was expected to be valid, nil returned", oneTestCase.name)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: the error was expected to be nil, error returned: %s", oneTestCase.name, err.Error())
+			continue
+		}
+
+		if !reflect.DeepEqual(output[0], oneTestCase.expected) {
+			t.Errorf("%s: the output was expected to be %v, %v returned", oneTestCase.name, oneTestCase.expected, output[0])
+			continue
+		}
+	}
+}
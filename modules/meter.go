@@ -0,0 +1,158 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/steve-care-software/rodan/limits"
+)
+
+type meterKey struct{}
+
+type meter struct {
+	limits       limits.Limits
+	mutex        sync.Mutex
+	depth        uint
+	instructions uint
+	readBytes    uint
+	writtenBytes uint
+	handles      uint
+	pDeadline    *time.Time
+}
+
+func createMeter(
+	limits limits.Limits,
+) *meter {
+	out := meter{
+		limits: limits,
+	}
+
+	return &out
+}
+
+// meterFromContext returns the meter of the interpretation the context belongs to, the fallback if none
+func meterFromContext(ctx context.Context, fallback *meter) *meter {
+	if ins, ok := ctx.Value(meterKey{}).(*meter); ok {
+		return ins
+	}
+
+	return fallback
+}
+
+// withMeter returns a copy of the context whose executed modules are metered by the provided meter
+func withMeter(ctx context.Context, meter *meter) context.Context {
+	return context.WithValue(ctx, meterKey{}, meter)
+}
+
+// start returns a new meter sharing the limits of this one, so that each interpretation is metered on its own
+func (app *meter) start() *meter {
+	out := createMeter(app.limits)
+	if app.limits != nil && app.limits.HasDeadline() {
+		deadline := time.Now().Add(*app.limits.Deadline())
+		out.pDeadline = &deadline
+	}
+
+	return out
+}
+
+func (app *meter) enter() error {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+
+	// the nested vms are always bounded, so that a script interpreting itself cannot exhaust the stack:
+	max := uint(limits.DefaultDepth)
+	if app.limits != nil && app.limits.HasDepth() {
+		max = *app.limits.Depth()
+	}
+
+	if app.depth+1 > max {
+		return fmt.Errorf("%w (maximum: %d)", limits.ErrDepthExceeded, max)
+	}
+
+	app.depth++
+	return nil
+}
+
+func (app *meter) leave() {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+
+	if app.depth > 0 {
+		app.depth--
+	}
+}
+
+func (app *meter) instruction() error {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+
+	if app.pDeadline != nil && time.Now().After(*app.pDeadline) {
+		return fmt.Errorf("%w (deadline: %s)", limits.ErrDeadlineExceeded, app.pDeadline.Format(time.RFC3339Nano))
+	}
+
+	if app.limits != nil && app.limits.HasInstructions() {
+		max := *app.limits.Instructions()
+		if app.instructions+1 > max {
+			return fmt.Errorf("%w (maximum: %d)", limits.ErrInstructionsExceeded, max)
+		}
+	}
+
+	app.instructions++
+	return nil
+}
+
+func (app *meter) read(amount uint) error {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+
+	if app.limits != nil && app.limits.HasReadBytes() {
+		max := *app.limits.ReadBytes()
+		if app.readBytes+amount > max || app.readBytes+amount < app.readBytes {
+			return fmt.Errorf("%w (maximum: %d, already read: %d, requested: %d)", limits.ErrReadBytesExceeded, max, app.readBytes, amount)
+		}
+	}
+
+	app.readBytes += amount
+	return nil
+}
+
+func (app *meter) write(amount uint) error {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+
+	if app.limits != nil && app.limits.HasWrittenBytes() {
+		max := *app.limits.WrittenBytes()
+		if app.writtenBytes+amount > max || app.writtenBytes+amount < app.writtenBytes {
+			return fmt.Errorf("%w (maximum: %d, already written: %d, requested: %d)", limits.ErrWrittenBytesExceeded, max, app.writtenBytes, amount)
+		}
+	}
+
+	app.writtenBytes += amount
+	return nil
+}
+
+func (app *meter) open() error {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+
+	if app.limits != nil && app.limits.HasHandles() {
+		max := *app.limits.Handles()
+		if app.handles+1 > max {
+			return fmt.Errorf("%w (maximum: %d)", limits.ErrHandlesExceeded, max)
+		}
+	}
+
+	app.handles++
+	return nil
+}
+
+func (app *meter) close() {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+
+	if app.handles > 0 {
+		app.handles--
+	}
+}
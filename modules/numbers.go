@@ -0,0 +1,167 @@
+package modules
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// number represents a numeric input, integers being kept exact whatever their size
+type number struct {
+	isFloat  bool
+	isSigned bool
+	float    float64
+	integer  *big.Int
+}
+
+// promotion represents the kind of the result of an operation on two numbers
+type promotion uint8
+
+const (
+	// promotionUnsigned represents an operation on two unsigned integers, returning a uint
+	promotionUnsigned promotion = iota
+
+	// promotionSigned represents an operation on at least one signed integer and no float, returning an int
+	promotionSigned
+
+	// promotionFloat represents an operation on at least one float, returning a float64
+	promotionFloat
+)
+
+const divisionByZeroError = "the division by zero is undefined"
+
+var minInt = big.NewInt(math.MinInt64 >> (64 - strconv.IntSize))
+var maxInt = big.NewInt(math.MaxInt64 >> (64 - strconv.IntSize))
+var maxUint = new(big.Int).SetUint64(math.MaxUint64 >> (64 - strconv.IntSize))
+
+func inputNumber(input map[uint]interface{}, index uint) (number, error) {
+	ins, err := inputValue(input, index)
+	if err != nil {
+		return number{}, err
+	}
+
+	if value, ok := toNumber(ins); ok {
+		return value, nil
+	}
+
+	str := fmt.Sprintf("the input at index %d was expected to contain an integer or a float, %T provided", index, ins)
+	return number{}, errors.New(str)
+}
+
+// toNumber returns the value as a number, if it is an integer or a float
+func toNumber(ins interface{}) (number, bool) {
+	if value, ok := toFloat(ins); ok {
+		return number{
+			isFloat: true,
+			float:   value,
+		}, true
+	}
+
+	if value, ok := toInteger(ins); ok {
+		integer := new(big.Int).SetUint64(value.magnitude)
+		if value.isNegative {
+			integer.Neg(integer)
+		}
+
+		isUnsigned := false
+		switch ins.(type) {
+		case uint, uint8, uint16, uint32, uint64:
+			isUnsigned = true
+		}
+
+		return number{
+			isSigned: !isUnsigned,
+			integer:  integer,
+		}, true
+	}
+
+	return number{}, false
+}
+
+func promote(first number, second number) promotion {
+	if first.isFloat || second.isFloat {
+		return promotionFloat
+	}
+
+	if first.isSigned || second.isSigned {
+		return promotionSigned
+	}
+
+	return promotionUnsigned
+}
+
+// toFloat64 returns the number as a float64, rounded to the nearest float64 when it is an integer
+func (obj number) toFloat64() float64 {
+	if obj.isFloat {
+		return obj.float
+	}
+
+	out, _ := new(big.Float).SetInt(obj.integer).Float64()
+	return out
+}
+
+// toBigFloat returns the number as an exact big float
+func (obj number) toBigFloat() *big.Float {
+	if obj.isFloat {
+		return new(big.Float).SetFloat64(obj.float)
+	}
+
+	return new(big.Float).SetInt(obj.integer)
+}
+
+// compareNumbers returns -1, 0 or 1 when the first number is lower, equal or greater than the second number, exactly
+func compareNumbers(first number, second number) (int, error) {
+	if (first.isFloat && math.IsNaN(first.float)) || (second.isFloat && math.IsNaN(second.float)) {
+		return 0, errors.New("the numbers cannot be compared because at least one of them is not a number (NaN)")
+	}
+
+	if !first.isFloat && !second.isFloat {
+		return first.integer.Cmp(second.integer), nil
+	}
+
+	return first.toBigFloat().Cmp(second.toBigFloat()), nil
+}
+
+// fromInteger returns the integer as an int or a uint, depending on the promotion, if it fits
+func fromInteger(value *big.Int, kind promotion) (interface{}, error) {
+	if kind == promotionUnsigned {
+		if value.Sign() < 0 {
+			str := fmt.Sprintf("the result (%s) is negative and therefore cannot be returned as an unsigned integer", value.String())
+			return nil, errors.New(str)
+		}
+
+		if value.Cmp(maxUint) > 0 {
+			str := fmt.Sprintf("the result (%s) overflows an unsigned integer", value.String())
+			return nil, errors.New(str)
+		}
+
+		return uint(value.Uint64()), nil
+	}
+
+	if value.Cmp(minInt) < 0 || value.Cmp(maxInt) > 0 {
+		str := fmt.Sprintf("the result (%s) overflows a signed integer", value.String())
+		return nil, errors.New(str)
+	}
+
+	return int(value.Int64()), nil
+}
+
+func fromFloat(value float64) (interface{}, error) {
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		str := fmt.Sprintf("the result (%s) is not a finite number", strconv.FormatFloat(value, 'g', -1, 64))
+		return nil, errors.New(str)
+	}
+
+	return value, nil
+}
+
+// fromNumber returns the number in the type of the promotion
+func fromNumber(value number, kind promotion) (interface{}, error) {
+	if kind == promotionFloat {
+		return fromFloat(value.toFloat64())
+	}
+
+	return fromInteger(value.integer, kind)
+}
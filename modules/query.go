@@ -0,0 +1,340 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/steve-care-software/ast/domain/trees"
+	interpreter_applications "github.com/steve-care-software/interpreter/applications"
+	"github.com/steve-care-software/interpreter/domain/programs/modules"
+	query_applications "github.com/steve-care-software/query/applications"
+	query_queries "github.com/steve-care-software/query/domain/queries"
+)
+
+// queryReverseName represents the name of the reverse elements of the query tokens, as the queries of this repository name them
+const queryReverseName = "reverse"
+
+type queryContextsKey struct{}
+
+// queryContexts contains the contexts of the query executions in progress in an interpretation, the innermost last
+type queryContexts struct {
+	mutex sync.Mutex
+	list  []context.Context
+}
+
+// withQueryContexts returns a copy of the context whose query executions pass their context to the callables of the queries
+func withQueryContexts(ctx context.Context) context.Context {
+	return context.WithValue(ctx, queryContextsKey{}, &queryContexts{})
+}
+
+// queryContextsFromContext returns the contexts of the query executions of the interpretation, nil if none
+func queryContextsFromContext(ctx context.Context) *queryContexts {
+	ins, _ := ctx.Value(queryContextsKey{}).(*queryContexts)
+	return ins
+}
+
+func (obj *queryContexts) push(ctx context.Context) {
+	obj.mutex.Lock()
+	defer obj.mutex.Unlock()
+	obj.list = append(obj.list, ctx)
+}
+
+func (obj *queryContexts) pop() {
+	obj.mutex.Lock()
+	defer obj.mutex.Unlock()
+	obj.list = obj.list[:len(obj.list)-1]
+}
+
+// current returns the context of the innermost query execution, the provided context if no query is executing
+func (obj *queryContexts) current(ctx context.Context) context.Context {
+	if obj == nil {
+		return ctx
+	}
+
+	obj.mutex.Lock()
+	defer obj.mutex.Unlock()
+	if len(obj.list) <= 0 {
+		return ctx
+	}
+
+	return obj.list[len(obj.list)-1]
+}
+
+type query struct {
+	queryApplication query_applications.Application
+	builder          query_queries.Builder
+	queryFnBuilder   query_queries.QueryFnBuilder
+	tokenBuilder     query_queries.TokenBuilder
+	elementBuilder   query_queries.ElementBuilder
+	insideBuilder    query_queries.InsideBuilder
+	fetchersBuilder  query_queries.FetchersBuilder
+	fetcherBuilder   query_queries.FetcherBuilder
+	contentFnBuilder query_queries.ContentFnBuilder
+}
+
+func createQuery(
+	queryApplication query_applications.Application,
+	builder query_queries.Builder,
+	queryFnBuilder query_queries.QueryFnBuilder,
+	tokenBuilder query_queries.TokenBuilder,
+	elementBuilder query_queries.ElementBuilder,
+	insideBuilder query_queries.InsideBuilder,
+	fetchersBuilder query_queries.FetchersBuilder,
+	fetcherBuilder query_queries.FetcherBuilder,
+	contentFnBuilder query_queries.ContentFnBuilder,
+) *query {
+	out := query{
+		queryApplication: queryApplication,
+		builder:          builder,
+		queryFnBuilder:   queryFnBuilder,
+		tokenBuilder:     tokenBuilder,
+		elementBuilder:   elementBuilder,
+		insideBuilder:    insideBuilder,
+		fetchersBuilder:  fetchersBuilder,
+		fetcherBuilder:   fetcherBuilder,
+		contentFnBuilder: contentFnBuilder,
+	}
+
+	return &out
+}
+
+// Execute executes the application
+func (app *query) Execute() map[uint]modules.ExecuteContextFn {
+	return map[uint]modules.ExecuteContextFn{
+		ModuleQueryElement: withContext(app.queryElement()),
+		ModuleQueryToken:   withContext(app.queryToken()),
+		ModuleQueryFetcher: withContext(app.queryFetcher()),
+		ModuleQueryInside:  app.queryInside(),
+		ModuleQuery:        app.query(),
+		ModuleQueryExecute: app.queryExecute(),
+	}
+}
+
+func (app *query) queryElement() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		name, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		index, err := inputUint(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		return app.elementBuilder.Create().
+			WithName(string(name)).
+			WithIndex(index).
+			Now()
+	}
+}
+
+func (app *query) queryToken() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		name, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		element, ok := input[1].(query_queries.Element)
+		if !ok {
+			str := fmt.Sprintf("the input at index %d was expected to contain a query element", 1)
+			return nil, errors.New(str)
+		}
+
+		builder := app.tokenBuilder.Create().
+			WithName(string(name)).
+			WithElement(element).
+			WithReverseName(queryReverseName)
+
+		// the content index is optional, refining the contents of the element to the one at the index:
+		if _, ok := input[2]; ok {
+			contentIndex, err := inputUint(input, 2)
+			if err != nil {
+				return nil, err
+			}
+
+			builder.WithContent(contentIndex)
+		}
+
+		return builder.Now()
+	}
+}
+
+func (app *query) queryFetcher() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		builder := app.fetcherBuilder.Create()
+		switch casted := input[0].(type) {
+		case query_queries.Query:
+			builder.WithQuery(casted)
+		case []byte:
+			// the recursive fetchers execute the query of an enclosing token, by its name:
+			builder.WithRecursive(string(casted))
+		default:
+			str := fmt.Sprintf("the input at index %d was expected to contain a query or the token name of a recursive query, %T provided", 0, input[0])
+			return nil, errors.New(str)
+		}
+
+		return builder.Now()
+	}
+}
+
+func (app *query) queryInside() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
+		value, err := inputValue(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		if list, ok := value.([]interface{}); ok {
+			fetchersList := []query_queries.Fetcher{}
+			for index, oneFetcher := range list {
+				if casted, ok := oneFetcher.(query_queries.Fetcher); ok {
+					fetchersList = append(fetchersList, casted)
+					continue
+				}
+
+				str := fmt.Sprintf("the value at index: %d was expected to be a Fetcher instance", index)
+				return nil, errors.New(str)
+			}
+
+			fetchers, err := app.fetchersBuilder.Create().WithList(fetchersList).Now()
+			if err != nil {
+				return nil, err
+			}
+
+			return app.insideBuilder.Create().WithFetchers(fetchers).Now()
+		}
+
+		if !interpreter_applications.IsCallable(value) {
+			str := fmt.Sprintf("the input at index %d was expected to contain a list of fetchers or a callable", 0)
+			return nil, errors.New(str)
+		}
+
+		// the callable receives the contents as a list of trees and bytes, then returns the list of instances,
+		// executed with the context of the query execution rather than the one of the query creation:
+		contexts := queryContextsFromContext(ctx)
+		contentFn, err := app.contentFnBuilder.Create().
+			WithMulti(func(contents []trees.Content) ([]interface{}, error) {
+				values := []interface{}{}
+				for _, oneContent := range contents {
+					if oneContent.IsTree() {
+						values = append(values, oneContent.Tree())
+						continue
+					}
+
+					values = append(values, oneContent.Bytes(false))
+				}
+
+				output, err := interpreter_applications.ExecuteCallable(contexts.current(ctx), value, []interface{}{
+					values,
+				})
+
+				if err != nil {
+					return nil, err
+				}
+
+				if instances, ok := output.([]interface{}); ok {
+					return instances, nil
+				}
+
+				str := fmt.Sprintf("the inside callable was expected to return a list, %T returned", output)
+				return nil, errors.New(str)
+			}).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return app.insideBuilder.Create().WithFn(contentFn).Now()
+	}
+}
+
+func (app *query) query() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
+		token, ok := input[0].(query_queries.Token)
+		if !ok {
+			str := fmt.Sprintf("the input at index %d was expected to contain a query token", 0)
+			return nil, errors.New(str)
+		}
+
+		inside, ok := input[1].(query_queries.Inside)
+		if !ok {
+			str := fmt.Sprintf("the input at index %d was expected to contain a query inside", 1)
+			return nil, errors.New(str)
+		}
+
+		fn, err := inputValue(input, 2)
+		if err != nil {
+			return nil, err
+		}
+
+		if !interpreter_applications.IsCallable(fn) {
+			str := fmt.Sprintf("the input at index %d was expected to contain a callable", 2)
+			return nil, errors.New(str)
+		}
+
+		// the callable receives the list of instances found inside the token, then returns the instance of the query,
+		// executed with the context of the query execution rather than the one of the query creation:
+		contexts := queryContextsFromContext(ctx)
+		queryFn, err := app.queryFnBuilder.Create().
+			WithMulti(func(instances []interface{}) (interface{}, bool, error) {
+				output, err := interpreter_applications.ExecuteCallable(contexts.current(ctx), fn, []interface{}{
+					instances,
+				})
+
+				if err != nil {
+					return nil, false, err
+				}
+
+				return output, true, nil
+			}).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return app.builder.Create().
+			WithToken(token).
+			WithInside(inside).
+			WithFn(queryFn).
+			Now()
+	}
+}
+
+func (app *query) queryExecute() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
+		queryIns, ok := input[0].(query_queries.Query)
+		if !ok {
+			str := fmt.Sprintf("the input at index %d was expected to contain a query", 0)
+			return nil, errors.New(str)
+		}
+
+		tree, ok := input[1].(trees.Tree)
+		if !ok {
+			str := fmt.Sprintf("the input at index %d was expected to contain a tree", 1)
+			return nil, errors.New(str)
+		}
+
+		if contexts := queryContextsFromContext(ctx); contexts != nil {
+			contexts.push(ctx)
+			defer contexts.pop()
+		}
+
+		output, isValid, _, err := app.queryApplication.Execute(queryIns, tree)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isValid {
+			str := fmt.Sprintf("the query (token: %s) found no instance in the tree (token: %s)", queryIns.Token().Name(), tree.Grammar().Name())
+			return nil, errors.New(str)
+		}
+
+		return output, nil
+	}
+}
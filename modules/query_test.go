@@ -0,0 +1,243 @@
+package modules
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+
+	interpreter_applications "github.com/steve-care-software/interpreter/applications"
+)
+
+func TestQuery_Success(t *testing.T) {
+	declarations := `
+		module @list:0;;
+		module @listFetchElement:1;;
+		module @treeBytes:125;;
+		module @queryElement:131;;
+		module @queryToken:132;;
+		module @queryFetcher:133;;
+		module @queryInside:134;;
+		module @query:135;;
+		module @queryExecute:136;;
+
+		-> $tree;;
+		<- $output;;
+
+		$zero = 0;;
+		$isFalse = false;;
+		$instructionsName = "instructions";;
+		$instructionName = "instruction";;
+		$assignmentName = "assignment";;
+		$variableAssignmentName = "variableAssignment";;
+		$variableReferenceName = "variableReference";;
+		$contentsToBytes = {
+			-> $contents;;
+			<- $values;;
+
+			$content = @listFetchElement($zero, $contents);;
+			$bytes = @treeBytes($content, $isFalse);;
+			$values = @list($bytes);;
+		};;
+		$first = {
+			-> $instances;;
+			<- $value;;
+
+			$value = @listFetchElement($zero, $instances);;
+		};;
+		$all = {
+			-> $instances;;
+			<- $value;;
+
+			$value = $instances;;
+		};;
+
+		$assignmentElement = @queryElement($assignmentName, $zero);;
+		$assignmentToken = @queryToken($instructionName, $assignmentElement);;
+		$assignmentInside = @queryInside($contentsToBytes);;
+		$assignmentQuery = @query($assignmentToken, $assignmentInside, $first);;
+		$assignmentFetcher = @queryFetcher($assignmentQuery);;
+		$assignmentFetchers = @list($assignmentFetcher);;
+		$instructionElement = @queryElement($instructionName, $zero);;
+		$instructionsToken = @queryToken($instructionsName, $instructionElement);;
+		$instructionsInside = @queryInside($assignmentFetchers);;
+		$instructionsQuery = @query($instructionsToken, $instructionsInside, $all);;
+	`
+
+	testCases := []struct {
+		name     string
+		script   string
+		expected interface{}
+		isError  bool
+	}{
+		{name: "execute, fetchers", script: "$output = @queryExecute($instructionsQuery, $tree);;", expected: []interface{}{[]byte("$first=$input"), []byte("$second=$first")}},
+		{
+			name: "execute, content index",
+			script: `
+				$referenceElement = @queryElement($variableReferenceName, $zero);;
+				$referenceToken = @queryToken($variableAssignmentName, $referenceElement, $zero);;
+				$referenceInside = @queryInside($contentsToBytes);;
+				$referenceQuery = @query($referenceToken, $referenceInside, $first);;
+				$referenceFetcher = @queryFetcher($referenceQuery);;
+				$referenceFetchers = @list($referenceFetcher);;
+				$variableAssignmentElement = @queryElement($variableAssignmentName, $zero);;
+				$variableAssignmentToken = @queryToken($assignmentName, $variableAssignmentElement);;
+				$variableAssignmentInside = @queryInside($referenceFetchers);;
+				$variableAssignmentQuery = @query($variableAssignmentToken, $variableAssignmentInside, $first);;
+				$variableAssignmentFetcher = @queryFetcher($variableAssignmentQuery);;
+				$variableAssignmentFetchers = @list($variableAssignmentFetcher);;
+				$instructionToken = @queryToken($instructionName, $assignmentElement);;
+				$instructionInside = @queryInside($variableAssignmentFetchers);;
+				$instructionQuery = @query($instructionToken, $instructionInside, $first);;
+				$instructionFetcher = @queryFetcher($instructionQuery);;
+				$instructionFetchers = @list($instructionFetcher);;
+				$referencesInside = @queryInside($instructionFetchers);;
+				$referencesQuery = @query($instructionsToken, $referencesInside, $all);;
+				$output = @queryExecute($referencesQuery, $tree);;
+			`,
+			expected: []interface{}{[]byte("$first"), []byte("$second")},
+		},
+		{
+			name: "execute, recursive fetcher on another token",
+			script: `
+				$recursiveFetcher = @queryFetcher($instructionsName);;
+				$recursiveFetchers = @list($recursiveFetcher);;
+				$recursiveInside = @queryInside($recursiveFetchers);;
+				$recursiveToken = @queryToken($instructionName, $assignmentElement);;
+				$recursiveQuery = @query($recursiveToken, $recursiveInside, $first);;
+				$recursiveQueryFetcher = @queryFetcher($recursiveQuery);;
+				$recursiveQueryFetchers = @list($recursiveQueryFetcher);;
+				$rootInside = @queryInside($recursiveQueryFetchers);;
+				$rootQuery = @query($instructionsToken, $rootInside, $all);;
+				$output = @queryExecute($rootQuery, $tree);;
+			`,
+			isError: true,
+		},
+		{name: "execute, token not matching the tree", script: "$output = @queryExecute($assignmentQuery, $tree);;", isError: true},
+		{name: "execute, not a tree", script: "$output = @queryExecute($instructionsQuery, $zero);;", isError: true},
+		{name: "element, index not an uint", script: "$output = @queryElement($assignmentName, $isFalse);;", isError: true},
+		{name: "token, not an element", script: "$output = @queryToken($instructionName, $zero);;", isError: true},
+		{name: "fetcher, not a query", script: "$output = @queryFetcher($zero);;", isError: true},
+		{name: "inside, not a fetcher", script: "$values = @list($zero);;\n$output = @queryInside($values);;", isError: true},
+		{name: "inside, not a callable", script: "$output = @queryInside($zero);;", isError: true},
+		{name: "query, not a callable", script: "$output = @query($assignmentToken, $assignmentInside, $zero);;", isError: true},
+	}
+
+	tree := executeTree(t, "$first = $input;;\n$second = $first;;\n")
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	for _, oneTestCase := range testCases {
+		treeIns, err := application.Lex([]byte(declarations + oneTestCase.script))
+		if err != nil {
+			t.Errorf("%s: the error was expected to be nil, error returned: %s", oneTestCase.name, err.Error())
+			continue
+		}
+
+		program, _, err := application.Parse(treeIns)
+		if err != nil {
+			t.Errorf("%s: the error was expected to be nil, error returned: %s", oneTestCase.name, err.Error())
+			continue
+		}
+
+		output, err := application.Interpret([]interface{}{tree}, program)
+		if oneTestCase.isError {
+			if err == nil {
+				t.Errorf("%s: the error was expected to be valid, nil returned", oneTestCase.name)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: the error was expected to be nil, error returned: %s", oneTestCase.name, err.Error())
+			continue
+		}
+
+		if !reflect.DeepEqual(output[0], oneTestCase.expected) {
+			t.Errorf("%s: the output was expected to be %v, %v returned", oneTestCase.name, oneTestCase.expected, output[0])
+			continue
+		}
+	}
+}
+
+func TestQuery_callablesExecuteWithExecutionContext_Success(t *testing.T) {
+	script := `
+		module @list:0;;
+		module @listFetchElement:1;;
+		module @queryElement:131;;
+		module @queryToken:132;;
+		module @queryInside:134;;
+		module @query:135;;
+		module @queryExecute:136;;
+
+		-> $tree;;
+
+		$zero = 0;;
+		$instructionsName = "instructions";;
+		$instructionName = "instruction";;
+		$contentsToList = {
+			-> $contents;;
+			<- $values;;
+
+			$values = @list($contents);;
+		};;
+		$failing = {
+			-> $instances;;
+			<- $value;;
+
+			$empty = @list();;
+			$value = @listFetchElement($zero, $empty);;
+		};;
+
+		$instructionElement = @queryElement($instructionName, $zero);;
+		$instructionsToken = @queryToken($instructionsName, $instructionElement);;
+		$instructionsInside = @queryInside($contentsToList);;
+		$instructionsQuery = @query($instructionsToken, $instructionsInside, $failing);;
+		@queryExecute($instructionsQuery, $tree);;
+	`
+
+	tree := executeTree(t, "$first = $input;;\n")
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = application.Interpret([]interface{}{tree}, program)
+	var pErr *interpreter_applications.Error
+	if !errors.As(err, &pErr) {
+		t.Errorf("the error was expected to contain an Error, returned: %v", err)
+		return
+	}
+
+	// the failing callable is positioned inside the execution of the query, not inside its creation:
+	expected := []uint{9, 1}
+	if !reflect.DeepEqual(pErr.Position, expected) {
+		t.Errorf("the position was expected to be %v, %v returned", expected, pErr.Position)
+		return
+	}
+}
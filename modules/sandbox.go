@@ -0,0 +1,53 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+type sandboxKey struct{}
+
+type sandbox struct {
+	path       string
+	isReadOnly bool
+}
+
+func createSandbox(
+	path string,
+	isReadOnly bool,
+) sandbox {
+	return sandbox{
+		path:       path,
+		isReadOnly: isReadOnly,
+	}
+}
+
+// sandboxFromContext returns the sandbox the context confines its scripts to, the whole base path if none
+func sandboxFromContext(ctx context.Context) sandbox {
+	if ins, ok := ctx.Value(sandboxKey{}).(sandbox); ok {
+		return ins
+	}
+
+	return createSandbox("", false)
+}
+
+// withSandbox confines the scripts executed with the returned context to a sub-directory of the current sandbox, read-only if requested or if the current sandbox already is
+func withSandbox(ctx context.Context, subDirectory string, isReadOnly bool) (context.Context, error) {
+	cleaned := filepath.Clean(subDirectory)
+	if filepath.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		str := fmt.Sprintf("the sub-directory (%s) was expected to be relative and to not seek before its parent directory", subDirectory)
+		return nil, errors.New(str)
+	}
+
+	current := sandboxFromContext(ctx)
+	path := filepath.Join(current.path, cleaned)
+	if path == "." {
+		path = ""
+	}
+
+	ins := createSandbox(path, current.isReadOnly || isReadOnly)
+	return context.WithValue(ctx, sandboxKey{}, ins), nil
+}
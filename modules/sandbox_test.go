@@ -0,0 +1,116 @@
+package modules
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSandbox_readOnlyChild_Success(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	err = os.Mkdir(filepath.Join(basePath, "child"), 0755)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	for _, oneName := range []string{"child/in.txt", "out.txt"} {
+		err = ioutil.WriteFile(filepath.Join(basePath, oneName), []byte("0123456789"), 0644)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+	}
+
+	ctx, err := createVM(nil, nil, nil).sandbox(context.Background(), map[uint]interface{}{
+		2: []byte("child"),
+		3: true,
+	}, 2)
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	fns := createFile(basePath, 1024, createMeter(nil), nil, nil).Execute()
+	pConn, err := fns[ModuleFileOpen](ctx, map[uint]interface{}{
+		0: []byte("in.txt"),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer fns[ModuleFileClose](ctx, map[uint]interface{}{
+		0: pConn,
+	})
+
+	data, err := fns[ModuleFileRead](ctx, map[uint]interface{}{
+		0: pConn,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if string(data.([]byte)) != "0123456789" {
+		t.Errorf("the data was expected to be '%s', '%s' returned", "0123456789", data)
+		return
+	}
+
+	_, err = fns[ModuleFileWrite](ctx, map[uint]interface{}{
+		0: pConn,
+		1: []byte("abc"),
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	_, err = fns[ModuleFileCreate](ctx, map[uint]interface{}{
+		0: []byte("created.txt"),
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	_, err = fns[ModuleFileLock](ctx, map[uint]interface{}{
+		0: "data.lock",
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	_, err = fns[ModuleFileOpen](ctx, map[uint]interface{}{
+		0: []byte("../out.txt"),
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestSandbox_seeksBeforeParent_returnsError(t *testing.T) {
+	ctx, _ := withSandbox(context.Background(), "child", false)
+	_, err := withSandbox(ctx, "../sibling", false)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
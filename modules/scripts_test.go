@@ -0,0 +1,203 @@
+package modules
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/steve-care-software/ast/domain/grammars"
+	"github.com/steve-care-software/ast/domain/grammars/cardinalities"
+	"github.com/steve-care-software/ast/domain/grammars/values"
+	vm_applications "github.com/steve-care-software/vm/applications"
+)
+
+// executeScriptFile interprets the shipped script at the path, relative to the root of the repository
+func executeScriptFile(application vm_applications.Application, basePath string, path string, input []interface{}) (interface{}, error) {
+	script, err := ioutil.ReadFile(filepath.Join(basePath, path))
+	if err != nil {
+		return nil, err
+	}
+
+	treeIns, err := application.Lex(script)
+	if err != nil {
+		return nil, err
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := application.Interpret(input, program)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) != 1 {
+		str := fmt.Sprintf("the script (path: %s) was expected to return %d output, %d returned", path, 1, len(output))
+		return nil, errors.New(str)
+	}
+
+	return output[0], nil
+}
+
+func createScriptElements(characters string) ([]interface{}, error) {
+	cardinality, err := cardinalities.NewBuilder().Create().WithMin(1).WithMax(1).Now()
+	if err != nil {
+		return nil, err
+	}
+
+	out := []interface{}{}
+	for _, oneCharacter := range []byte(characters) {
+		value, err := values.NewBuilder().Create().WithName(string(oneCharacter)).WithNumber(oneCharacter).Now()
+		if err != nil {
+			return nil, err
+		}
+
+		element, err := grammars.NewElementBuilder().Create().WithCardinality(cardinality).WithValue(value).Now()
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, element)
+	}
+
+	return out, nil
+}
+
+func TestScripts_grammarsCreate_Success(t *testing.T) {
+	// the shipped scripts import each other from the root of the repository:
+	basePath, err := filepath.Abs("..")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(basePath).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	numbers, err := createScriptElements("0123456789")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	lowerCaseLetters, err := createScriptElements("abcdefghijklmnopqrstuvwxyz")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	upperCaseLetters, err := createScriptElements("ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	assignmentSigns, err := createScriptElements("=")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	mandatorySingle, _ := cardinalities.NewBuilder().Create().WithMin(1).WithMax(1).Now()
+	optionalMultiple, _ := cardinalities.NewBuilder().Create().WithMin(0).Now()
+	tokens := map[string]grammars.Token{}
+	testCases := []struct {
+		name          string
+		path          string
+		input         func() []interface{}
+		expectedName  string
+		expectedLines int
+	}{
+		{
+			name: "anyNumber",
+			path: "scripts/grammars/create/token_any_number.rodan",
+			input: func() []interface{} {
+				return []interface{}{numbers}
+			},
+			expectedName:  "anyNumber",
+			expectedLines: 10,
+		},
+		{
+			name: "lowerCaseLetter",
+			path: "scripts/grammars/create/token_any_specific_letter.rodan",
+			input: func() []interface{} {
+				return []interface{}{lowerCaseLetters, []byte("lowerCaseLetter")}
+			},
+			expectedName:  "lowerCaseLetter",
+			expectedLines: 26,
+		},
+		{
+			name: "upperCaseLetter",
+			path: "scripts/grammars/create/token_any_specific_letter.rodan",
+			input: func() []interface{} {
+				return []interface{}{upperCaseLetters, []byte("upperCaseLetter")}
+			},
+			expectedName:  "upperCaseLetter",
+			expectedLines: 26,
+		},
+		{
+			name: "anyLetter",
+			path: "scripts/grammars/create/token_any_letter.rodan",
+			input: func() []interface{} {
+				return []interface{}{tokens["lowerCaseLetter"], tokens["upperCaseLetter"], mandatorySingle}
+			},
+			expectedName:  "anyLetter",
+			expectedLines: 2,
+		},
+		{
+			name: "variableName",
+			path: "scripts/grammars/create/token_variable_name.rodan",
+			input: func() []interface{} {
+				return []interface{}{tokens["lowerCaseLetter"], tokens["anyLetter"], mandatorySingle, optionalMultiple}
+			},
+			expectedName:  "anyLetter",
+			expectedLines: 2,
+		},
+		{
+			name: "nameValueAssignment",
+			path: "scripts/grammars/create/token_name_value_assignment.rodan",
+			input: func() []interface{} {
+				return []interface{}{tokens["variableName"], assignmentSigns[0], tokens["anyNumber"], mandatorySingle}
+			},
+			expectedName:  "nameValueAssignment",
+			expectedLines: 1,
+		},
+	}
+
+	for _, oneTestCase := range testCases {
+		output, err := executeScriptFile(application, basePath, oneTestCase.path, oneTestCase.input())
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s (test case: %s)", err.Error(), oneTestCase.name)
+			return
+		}
+
+		token, ok := output.(grammars.Token)
+		if !ok {
+			t.Errorf("the output was expected to be a Token (test case: %s)", oneTestCase.name)
+			return
+		}
+
+		if token.Name() != oneTestCase.expectedName {
+			t.Errorf("the token name was expected to be '%s', '%s' returned (test case: %s)", oneTestCase.expectedName, token.Name(), oneTestCase.name)
+			return
+		}
+
+		if len(token.Block().Lines()) != oneTestCase.expectedLines {
+			t.Errorf("the token was expected to contain %d lines, %d returned (test case: %s)", oneTestCase.expectedLines, len(token.Block().Lines()), oneTestCase.name)
+			return
+		}
+
+		tokens[oneTestCase.name] = token
+	}
+}
@@ -1,15 +1,22 @@
 package modules
 
 import (
+	"context"
 	"path/filepath"
 
 	"github.com/steve-care-software/ast/applications"
 	"github.com/steve-care-software/ast/domain/grammars"
 	"github.com/steve-care-software/ast/domain/grammars/cardinalities"
 	"github.com/steve-care-software/ast/domain/grammars/values"
+	interpreter_applications "github.com/steve-care-software/interpreter/applications"
 	"github.com/steve-care-software/interpreter/domain/programs/modules"
+	query_applications "github.com/steve-care-software/query/applications"
+	query_queries "github.com/steve-care-software/query/domain/queries"
+	"github.com/steve-care-software/rodan/capabilities"
 	rodan_grammars "github.com/steve-care-software/rodan/grammars"
+	"github.com/steve-care-software/rodan/limits"
 	"github.com/steve-care-software/rodan/queries"
+	"github.com/steve-care-software/rodan/traces"
 	vm_applications "github.com/steve-care-software/vm/applications"
 )
 
@@ -122,20 +129,826 @@ const (
 
 	// ModuleVMLexParseInterpretThenReturnSingle represents a vm lex, parse, interpreter then return single module
 	ModuleVMLexParseInterpretThenReturnSingle = 35
+
+	// ModuleErrorMessage represents the errorMessage module
+	ModuleErrorMessage = 36
+
+	// ModuleErrorHasModule represents the errorHasModule module
+	ModuleErrorHasModule = 37
+
+	// ModuleErrorModuleName represents the errorModuleName module
+	ModuleErrorModuleName = 38
+
+	// ModuleErrorModuleIndex represents the errorModuleIndex module
+	ModuleErrorModuleIndex = 39
+
+	// ModuleErrorPosition represents the errorPosition module
+	ModuleErrorPosition = 40
+
+	// ModuleListLength represents a list length module
+	ModuleListLength = 41
+
+	// ModuleListAppend represents a list append module
+	ModuleListAppend = 42
+
+	// ModuleListPrepend represents a list prepend module
+	ModuleListPrepend = 43
+
+	// ModuleListConcat represents a list concat module
+	ModuleListConcat = 44
+
+	// ModuleListSlice represents a list slice module
+	ModuleListSlice = 45
+
+	// ModuleListReverse represents a list reverse module
+	ModuleListReverse = 46
+
+	// ModuleListContains represents a list contains module
+	ModuleListContains = 47
+
+	// ModuleListIndexOf represents a list index of module
+	ModuleListIndexOf = 48
+
+	// ModuleListUnique represents a list unique module
+	ModuleListUnique = 49
+
+	// ModuleListSort represents a list sort module
+	ModuleListSort = 50
+
+	// ModuleMap represents a map module
+	ModuleMap = 51
+
+	// ModuleMapSet represents a map set module
+	ModuleMapSet = 52
+
+	// ModuleMapGet represents a map get module
+	ModuleMapGet = 53
+
+	// ModuleMapHas represents a map has module
+	ModuleMapHas = 54
+
+	// ModuleMapDelete represents a map delete module
+	ModuleMapDelete = 55
+
+	// ModuleMapKeys represents a map keys module
+	ModuleMapKeys = 56
+
+	// ModuleMapValues represents a map values module
+	ModuleMapValues = 57
+
+	// ModuleMapMerge represents a map merge module
+	ModuleMapMerge = 58
+
+	// ModuleCastToMap represents the castToMap module
+	ModuleCastToMap = 59
+
+	// ModuleCastToList represents the castToList module
+	ModuleCastToList = 60
+
+	// ModuleCastToBytes represents the castToBytes module
+	ModuleCastToBytes = 61
+
+	// ModuleCastToString represents the castToString module
+	ModuleCastToString = 62
+
+	// ModuleCastToInt8 represents the castToInt8 module
+	ModuleCastToInt8 = 63
+
+	// ModuleCastToInt16 represents the castToInt16 module
+	ModuleCastToInt16 = 64
+
+	// ModuleCastToInt32 represents the castToInt32 module
+	ModuleCastToInt32 = 65
+
+	// ModuleCastToInt64 represents the castToInt64 module
+	ModuleCastToInt64 = 66
+
+	// ModuleCastToUint8 represents the castToUint8 module
+	ModuleCastToUint8 = 67
+
+	// ModuleCastToUint16 represents the castToUint16 module
+	ModuleCastToUint16 = 68
+
+	// ModuleCastToUint32 represents the castToUint32 module
+	ModuleCastToUint32 = 69
+
+	// ModuleCastToUint64 represents the castToUint64 module
+	ModuleCastToUint64 = 70
+
+	// ModuleAdd represents an add module
+	ModuleAdd = 71
+
+	// ModuleSub represents a sub module
+	ModuleSub = 72
+
+	// ModuleMul represents a mul module
+	ModuleMul = 73
+
+	// ModuleDiv represents a div module
+	ModuleDiv = 74
+
+	// ModuleMod represents a mod module
+	ModuleMod = 75
+
+	// ModuleMin represents a min module
+	ModuleMin = 76
+
+	// ModuleMax represents a max module
+	ModuleMax = 77
+
+	// ModuleEq represents an eq module
+	ModuleEq = 78
+
+	// ModuleLt represents a lt module
+	ModuleLt = 79
+
+	// ModuleGt represents a gt module
+	ModuleGt = 80
+
+	// ModuleAnd represents an and module
+	ModuleAnd = 81
+
+	// ModuleOr represents an or module
+	ModuleOr = 82
+
+	// ModuleNot represents a not module
+	ModuleNot = 83
+
+	// ModuleTextConcat represents a text concat module
+	ModuleTextConcat = 84
+
+	// ModuleTextSplit represents a text split module
+	ModuleTextSplit = 85
+
+	// ModuleTextJoin represents a text join module
+	ModuleTextJoin = 86
+
+	// ModuleTextTrim represents a text trim module
+	ModuleTextTrim = 87
+
+	// ModuleTextTrimPrefix represents a text trim prefix module
+	ModuleTextTrimPrefix = 88
+
+	// ModuleTextTrimSuffix represents a text trim suffix module
+	ModuleTextTrimSuffix = 89
+
+	// ModuleTextContains represents a text contains module
+	ModuleTextContains = 90
+
+	// ModuleTextIndex represents a text index module
+	ModuleTextIndex = 91
+
+	// ModuleTextReplace represents a text replace module
+	ModuleTextReplace = 92
+
+	// ModuleTextSlice represents a text slice module
+	ModuleTextSlice = 93
+
+	// ModuleTextUpper represents a text upper module
+	ModuleTextUpper = 94
+
+	// ModuleTextLower represents a text lower module
+	ModuleTextLower = 95
+
+	// ModuleTextLength represents a text length module
+	ModuleTextLength = 96
+
+	// ModuleHexEncode represents a hex encode module
+	ModuleHexEncode = 97
+
+	// ModuleHexDecode represents a hex decode module
+	ModuleHexDecode = 98
+
+	// ModuleBase64Encode represents a base64 encode module
+	ModuleBase64Encode = 99
+
+	// ModuleBase64Decode represents a base64 decode module
+	ModuleBase64Decode = 100
+
+	// ModuleBase64URLEncode represents a base64 URL encode module
+	ModuleBase64URLEncode = 101
+
+	// ModuleBase64URLDecode represents a base64 URL decode module
+	ModuleBase64URLDecode = 102
+
+	// ModuleJSONEncode represents a JSON encode module
+	ModuleJSONEncode = 103
+
+	// ModuleJSONDecode represents a JSON decode module
+	ModuleJSONDecode = 104
+
+	// ModuleCBOREncode represents a CBOR encode module
+	ModuleCBOREncode = 105
+
+	// ModuleCBORDecode represents a CBOR decode module
+	ModuleCBORDecode = 106
+
+	// ModuleHashSHA256 represents a SHA-256 hash module
+	ModuleHashSHA256 = 107
+
+	// ModuleHashSHA512 represents a SHA-512 hash module
+	ModuleHashSHA512 = 108
+
+	// ModuleHMACSHA256 represents a HMAC-SHA-256 module
+	ModuleHMACSHA256 = 109
+
+	// ModuleHMACSHA512 represents a HMAC-SHA-512 module
+	ModuleHMACSHA512 = 110
+
+	// ModuleEd25519GenerateKey represents an ed25519 key generation module
+	ModuleEd25519GenerateKey = 111
+
+	// ModuleEd25519PublicKey represents an ed25519 public key module
+	ModuleEd25519PublicKey = 112
+
+	// ModuleEd25519Sign represents an ed25519 sign module
+	ModuleEd25519Sign = 113
+
+	// ModuleEd25519Verify represents an ed25519 verify module
+	ModuleEd25519Verify = 114
+
+	// ModuleConstantTimeCompare represents a constant time compare module
+	ModuleConstantTimeCompare = 115
+
+	// ModuleRandomBytes represents a random bytes module
+	ModuleRandomBytes = 116
+
+	// ModulePBKDF2Key represents a PBKDF2 key derivation module
+	ModulePBKDF2Key = 117
+
+	// ModuleAESSeal represents an AES-GCM seal module
+	ModuleAESSeal = 118
+
+	// ModuleAESOpen represents an AES-GCM open module
+	ModuleAESOpen = 119
+
+	// ModuleBoxGenerateKey represents a sealed box key generation module
+	ModuleBoxGenerateKey = 120
+
+	// ModuleBoxPublicKey represents a sealed box public key module
+	ModuleBoxPublicKey = 121
+
+	// ModuleBoxSeal represents a sealed box seal module
+	ModuleBoxSeal = 122
+
+	// ModuleBoxOpen represents a sealed box open module
+	ModuleBoxOpen = 123
+
+	// ModuleTreeName represents a tree token name module
+	ModuleTreeName = 124
+
+	// ModuleTreeBytes represents a tree bytes module
+	ModuleTreeBytes = 125
+
+	// ModuleTreeHasRemaining represents a tree has remaining module
+	ModuleTreeHasRemaining = 126
+
+	// ModuleTreeRemaining represents a tree remaining module
+	ModuleTreeRemaining = 127
+
+	// ModuleTreeFetch represents a tree fetch module
+	ModuleTreeFetch = 128
+
+	// ModuleTreeFetchAll represents a tree fetch all module
+	ModuleTreeFetchAll = 129
+
+	// ModuleTreeChildren represents a tree children module
+	ModuleTreeChildren = 130
+
+	// ModuleQueryElement represents a query element module
+	ModuleQueryElement = 131
+
+	// ModuleQueryToken represents a query token module
+	ModuleQueryToken = 132
+
+	// ModuleQueryFetcher represents a query fetcher module
+	ModuleQueryFetcher = 133
+
+	// ModuleQueryInside represents a query inside module
+	ModuleQueryInside = 134
+
+	// ModuleQuery represents a query module
+	ModuleQuery = 135
+
+	// ModuleQueryExecute represents a query execute module
+	ModuleQueryExecute = 136
+
+	// ModuleFileCreate represents a file create module
+	ModuleFileCreate = 137
+
+	// ModuleHashBLAKE2b represents a BLAKE2b-512 hash module
+	ModuleHashBLAKE2b = 138
 )
 
+var moduleGroups = map[string][]uint{
+	capabilities.List: {
+		ModuleList,
+		ModuleListFetchElement,
+		ModuleListLength,
+		ModuleListAppend,
+		ModuleListPrepend,
+		ModuleListConcat,
+		ModuleListSlice,
+		ModuleListReverse,
+		ModuleListContains,
+		ModuleListIndexOf,
+		ModuleListUnique,
+		ModuleListSort,
+	},
+	capabilities.Cast: {
+		ModuleCastToInt,
+		ModuleCastToUint,
+		ModuleCastToBool,
+		ModuleCastToFloat32,
+		ModuleCastToFloat64,
+		ModuleCastToMap,
+		ModuleCastToList,
+		ModuleCastToBytes,
+		ModuleCastToString,
+		ModuleCastToInt8,
+		ModuleCastToInt16,
+		ModuleCastToInt32,
+		ModuleCastToInt64,
+		ModuleCastToUint8,
+		ModuleCastToUint16,
+		ModuleCastToUint32,
+		ModuleCastToUint64,
+	},
+	capabilities.Math: {
+		ModuleAdd,
+		ModuleSub,
+		ModuleMul,
+		ModuleDiv,
+		ModuleMod,
+		ModuleMin,
+		ModuleMax,
+	},
+	capabilities.Logic: {
+		ModuleEq,
+		ModuleLt,
+		ModuleGt,
+		ModuleAnd,
+		ModuleOr,
+		ModuleNot,
+	},
+	capabilities.Text: {
+		ModuleTextConcat,
+		ModuleTextSplit,
+		ModuleTextJoin,
+		ModuleTextTrim,
+		ModuleTextTrimPrefix,
+		ModuleTextTrimSuffix,
+		ModuleTextContains,
+		ModuleTextIndex,
+		ModuleTextReplace,
+		ModuleTextSlice,
+		ModuleTextUpper,
+		ModuleTextLower,
+		ModuleTextLength,
+	},
+	capabilities.Encoding: {
+		ModuleHexEncode,
+		ModuleHexDecode,
+		ModuleBase64Encode,
+		ModuleBase64Decode,
+		ModuleBase64URLEncode,
+		ModuleBase64URLDecode,
+		ModuleJSONEncode,
+		ModuleJSONDecode,
+		ModuleCBOREncode,
+		ModuleCBORDecode,
+	},
+	capabilities.Crypto: {
+		ModuleHashSHA256,
+		ModuleHashSHA512,
+		ModuleHashBLAKE2b,
+		ModuleHMACSHA256,
+		ModuleHMACSHA512,
+		ModuleEd25519GenerateKey,
+		ModuleEd25519PublicKey,
+		ModuleEd25519Sign,
+		ModuleEd25519Verify,
+		ModuleConstantTimeCompare,
+		ModuleRandomBytes,
+		ModulePBKDF2Key,
+		ModuleAESSeal,
+		ModuleAESOpen,
+		ModuleBoxGenerateKey,
+		ModuleBoxPublicKey,
+		ModuleBoxSeal,
+		ModuleBoxOpen,
+	},
+	capabilities.Map: {
+		ModuleMap,
+		ModuleMapSet,
+		ModuleMapGet,
+		ModuleMapHas,
+		ModuleMapDelete,
+		ModuleMapKeys,
+		ModuleMapValues,
+		ModuleMapMerge,
+	},
+	capabilities.FileRead: {
+		ModuleFileOpen,
+		ModuleFileClose,
+		ModuleFileInfo,
+		ModuleFileRead,
+	},
+	capabilities.FileWrite: {
+		ModuleFileOpen,
+		ModuleFileCreate,
+		ModuleFileClose,
+		ModuleFileInfo,
+		ModuleFileWrite,
+	},
+	capabilities.FileLock: {
+		ModuleFileLock,
+		ModuleFileUnLock,
+	},
+	capabilities.AST: {
+		ModuleASTValue,
+		ModuleASTCardinality,
+		ModuleASTElement,
+		ModuleASTContainer,
+		ModuleASTLine,
+		ModuleASTBlock,
+		ModuleASTSuite,
+		ModuleASTSuites,
+		ModuleASTToken,
+		ModuleASTEverything,
+		ModuleASTInstance,
+		ModuleASTExternal,
+		ModuleASTChannelCondition,
+		ModuleASTChannel,
+		ModuleASTChannels,
+		ModuleAST,
+	},
+	capabilities.ASTExecute: {
+		ModuleASTExecute,
+	},
+	capabilities.Tree: {
+		ModuleTreeName,
+		ModuleTreeBytes,
+		ModuleTreeHasRemaining,
+		ModuleTreeRemaining,
+		ModuleTreeFetch,
+		ModuleTreeFetchAll,
+		ModuleTreeChildren,
+	},
+	capabilities.Query: {
+		ModuleQueryElement,
+		ModuleQueryToken,
+		ModuleQueryFetcher,
+		ModuleQueryInside,
+		ModuleQuery,
+		ModuleQueryExecute,
+	},
+	capabilities.VM: {
+		ModuleVMLex,
+		ModuleVMParse,
+		ModuleVMInterpret,
+		ModuleVMLexParseThenInterpret,
+		ModuleVMLexParseInterpretThenReturnSingle,
+	},
+	capabilities.Error: {
+		ModuleErrorMessage,
+		ModuleErrorHasModule,
+		ModuleErrorModuleName,
+		ModuleErrorModuleIndex,
+		ModuleErrorPosition,
+	},
+}
+
+// moduleInputs contains the amount of inputs read by the modules reading a fixed amount of inputs.
+// The vm modules interpreting a program or a script read it, then its input, then the sandbox of the nested script:
+// the sub-directory of the current sandbox, then whether it is read-only. The modules lexing the script then read
+// its capabilities manifest. A path is accessible to the nested script when it is both inside its sandbox and inside
+// a root of its capabilities.
+var moduleInputs = map[uint]uint{
+	ModuleListFetchElement:        2,
+	ModuleFileOpen:                1,
+	ModuleFileCreate:              1,
+	ModuleFileClose:               1,
+	ModuleFileLock:                2,
+	ModuleFileUnLock:              1,
+	ModuleFileInfo:                1,
+	ModuleFileRead:                3,
+	ModuleFileWrite:               3,
+	ModuleCastToInt:               1,
+	ModuleCastToUint:              1,
+	ModuleCastToBool:              1,
+	ModuleCastToFloat32:           1,
+	ModuleCastToFloat64:           1,
+	ModuleASTValue:                2,
+	ModuleASTCardinality:          2,
+	ModuleASTElement:              4,
+	ModuleASTContainer:            2,
+	ModuleASTLine:                 1,
+	ModuleASTBlock:                1,
+	ModuleASTSuite:                2,
+	ModuleASTSuites:               1,
+	ModuleASTToken:                3,
+	ModuleASTEverything:           3,
+	ModuleASTInstance:             2,
+	ModuleASTExternal:             2,
+	ModuleASTChannelCondition:     2,
+	ModuleASTChannel:              2,
+	ModuleASTChannels:             1,
+	ModuleAST:                     2,
+	ModuleASTExecute:              2,
+	ModuleVMLex:                   1,
+	ModuleVMParse:                 1,
+	ModuleVMInterpret:             4,
+	ModuleVMLexParseThenInterpret: 5,
+	ModuleVMLexParseInterpretThenReturnSingle: 5,
+	ModuleErrorMessage:                        1,
+	ModuleErrorHasModule:                      1,
+	ModuleErrorModuleName:                     1,
+	ModuleErrorModuleIndex:                    1,
+	ModuleErrorPosition:                       1,
+	ModuleListLength:                          1,
+	ModuleListAppend:                          2,
+	ModuleListPrepend:                         2,
+	ModuleListConcat:                          2,
+	ModuleListSlice:                           3,
+	ModuleListReverse:                         1,
+	ModuleListContains:                        2,
+	ModuleListIndexOf:                         2,
+	ModuleListUnique:                          1,
+	ModuleListSort:                            2,
+	ModuleMap:                                 0,
+	ModuleMapSet:                              3,
+	ModuleMapGet:                              2,
+	ModuleMapHas:                              2,
+	ModuleMapDelete:                           2,
+	ModuleMapKeys:                             1,
+	ModuleMapValues:                           1,
+	ModuleMapMerge:                            2,
+	ModuleCastToMap:                           1,
+	ModuleCastToList:                          1,
+	ModuleCastToBytes:                         1,
+	ModuleCastToString:                        1,
+	ModuleCastToInt8:                          1,
+	ModuleCastToInt16:                         1,
+	ModuleCastToInt32:                         1,
+	ModuleCastToInt64:                         1,
+	ModuleCastToUint8:                         1,
+	ModuleCastToUint16:                        1,
+	ModuleCastToUint32:                        1,
+	ModuleCastToUint64:                        1,
+	ModuleAdd:                                 2,
+	ModuleSub:                                 2,
+	ModuleMul:                                 2,
+	ModuleDiv:                                 2,
+	ModuleMod:                                 2,
+	ModuleMin:                                 2,
+	ModuleMax:                                 2,
+	ModuleEq:                                  2,
+	ModuleLt:                                  2,
+	ModuleGt:                                  2,
+	ModuleAnd:                                 2,
+	ModuleOr:                                  2,
+	ModuleNot:                                 1,
+	ModuleTextConcat:                          2,
+	ModuleTextSplit:                           2,
+	ModuleTextJoin:                            2,
+	ModuleTextTrim:                            1,
+	ModuleTextTrimPrefix:                      2,
+	ModuleTextTrimSuffix:                      2,
+	ModuleTextContains:                        2,
+	ModuleTextIndex:                           2,
+	ModuleTextReplace:                         3,
+	ModuleTextSlice:                           3,
+	ModuleTextUpper:                           1,
+	ModuleTextLower:                           1,
+	ModuleTextLength:                          1,
+	ModuleHexEncode:                           1,
+	ModuleHexDecode:                           1,
+	ModuleBase64Encode:                        1,
+	ModuleBase64Decode:                        1,
+	ModuleBase64URLEncode:                     1,
+	ModuleBase64URLDecode:                     1,
+	ModuleJSONEncode:                          1,
+	ModuleJSONDecode:                          1,
+	ModuleCBOREncode:                          1,
+	ModuleCBORDecode:                          1,
+	ModuleHashSHA256:                          1,
+	ModuleHashSHA512:                          1,
+	ModuleHashBLAKE2b:                         1,
+	ModuleHMACSHA256:                          2,
+	ModuleHMACSHA512:                          2,
+	ModuleEd25519GenerateKey:                  1,
+	ModuleEd25519PublicKey:                    1,
+	ModuleEd25519Sign:                         2,
+	ModuleEd25519Verify:                       3,
+	ModuleConstantTimeCompare:                 2,
+	ModuleRandomBytes:                         1,
+	ModulePBKDF2Key:                           3,
+	ModuleAESSeal:                             2,
+	ModuleAESOpen:                             2,
+	ModuleBoxGenerateKey:                      1,
+	ModuleBoxPublicKey:                        1,
+	ModuleBoxSeal:                             2,
+	ModuleBoxOpen:                             2,
+	ModuleTreeName:                            1,
+	ModuleTreeBytes:                           2,
+	ModuleTreeHasRemaining:                    1,
+	ModuleTreeRemaining:                       1,
+	ModuleTreeFetch:                           2,
+	ModuleTreeFetchAll:                        2,
+	ModuleTreeChildren:                        1,
+	ModuleQueryElement:                        2,
+	ModuleQueryFetcher:                        1,
+	ModuleQueryInside:                         1,
+	ModuleQuery:                               3,
+	ModuleQueryExecute:                        2,
+}
+
+// moduleNames contains the name of every module, as declared in the scripts of this repository
+var moduleNames = map[uint]string{
+	ModuleList:                    "list",
+	ModuleListFetchElement:        "listFetchElement",
+	ModuleFileOpen:                "fileOpen",
+	ModuleFileCreate:              "fileCreate",
+	ModuleFileClose:               "fileClose",
+	ModuleFileLock:                "fileLock",
+	ModuleFileUnLock:              "fileUnLock",
+	ModuleFileInfo:                "fileInfo",
+	ModuleFileRead:                "fileRead",
+	ModuleFileWrite:               "fileWrite",
+	ModuleCastToInt:               "castToInt",
+	ModuleCastToUint:              "castToUint",
+	ModuleCastToBool:              "castToBool",
+	ModuleCastToFloat32:           "castToFloat32",
+	ModuleCastToFloat64:           "castToFloat64",
+	ModuleASTValue:                "astValue",
+	ModuleASTCardinality:          "astCardinality",
+	ModuleASTElement:              "astElement",
+	ModuleASTContainer:            "astContainer",
+	ModuleASTLine:                 "astLine",
+	ModuleASTBlock:                "astBlock",
+	ModuleASTSuite:                "astSuite",
+	ModuleASTSuites:               "astSuites",
+	ModuleASTToken:                "astToken",
+	ModuleASTEverything:           "astEverything",
+	ModuleASTInstance:             "astInstance",
+	ModuleASTExternal:             "astExternal",
+	ModuleASTChannelCondition:     "astChannelCondition",
+	ModuleASTChannel:              "astChannel",
+	ModuleASTChannels:             "astChannels",
+	ModuleAST:                     "ast",
+	ModuleASTExecute:              "astExecute",
+	ModuleVMLex:                   "vmLex",
+	ModuleVMParse:                 "vmParse",
+	ModuleVMInterpret:             "vmInterpret",
+	ModuleVMLexParseThenInterpret: "vmLexParseThenInterpret",
+	ModuleVMLexParseInterpretThenReturnSingle: "vmLexParseInterpretThenReturnSingle",
+	ModuleErrorMessage:                        "errorMessage",
+	ModuleErrorHasModule:                      "errorHasModule",
+	ModuleErrorModuleName:                     "errorModuleName",
+	ModuleErrorModuleIndex:                    "errorModuleIndex",
+	ModuleErrorPosition:                       "errorPosition",
+	ModuleListLength:                          "listLength",
+	ModuleListAppend:                          "listAppend",
+	ModuleListPrepend:                         "listPrepend",
+	ModuleListConcat:                          "listConcat",
+	ModuleListSlice:                           "listSlice",
+	ModuleListReverse:                         "listReverse",
+	ModuleListContains:                        "listContains",
+	ModuleListIndexOf:                         "listIndexOf",
+	ModuleListUnique:                          "listUnique",
+	ModuleListSort:                            "listSort",
+	ModuleMap:                                 "map",
+	ModuleMapSet:                              "mapSet",
+	ModuleMapGet:                              "mapGet",
+	ModuleMapHas:                              "mapHas",
+	ModuleMapDelete:                           "mapDelete",
+	ModuleMapKeys:                             "mapKeys",
+	ModuleMapValues:                           "mapValues",
+	ModuleMapMerge:                            "mapMerge",
+	ModuleCastToMap:                           "castToMap",
+	ModuleCastToList:                          "castToList",
+	ModuleCastToBytes:                         "castToBytes",
+	ModuleCastToString:                        "castToString",
+	ModuleCastToInt8:                          "castToInt8",
+	ModuleCastToInt16:                         "castToInt16",
+	ModuleCastToInt32:                         "castToInt32",
+	ModuleCastToInt64:                         "castToInt64",
+	ModuleCastToUint8:                         "castToUint8",
+	ModuleCastToUint16:                        "castToUint16",
+	ModuleCastToUint32:                        "castToUint32",
+	ModuleCastToUint64:                        "castToUint64",
+	ModuleAdd:                                 "add",
+	ModuleSub:                                 "sub",
+	ModuleMul:                                 "mul",
+	ModuleDiv:                                 "div",
+	ModuleMod:                                 "mod",
+	ModuleMin:                                 "min",
+	ModuleMax:                                 "max",
+	ModuleEq:                                  "eq",
+	ModuleLt:                                  "lt",
+	ModuleGt:                                  "gt",
+	ModuleAnd:                                 "and",
+	ModuleOr:                                  "or",
+	ModuleNot:                                 "not",
+	ModuleTextConcat:                          "textConcat",
+	ModuleTextSplit:                           "textSplit",
+	ModuleTextJoin:                            "textJoin",
+	ModuleTextTrim:                            "textTrim",
+	ModuleTextTrimPrefix:                      "textTrimPrefix",
+	ModuleTextTrimSuffix:                      "textTrimSuffix",
+	ModuleTextContains:                        "textContains",
+	ModuleTextIndex:                           "textIndex",
+	ModuleTextReplace:                         "textReplace",
+	ModuleTextSlice:                           "textSlice",
+	ModuleTextUpper:                           "textUpper",
+	ModuleTextLower:                           "textLower",
+	ModuleTextLength:                          "textLength",
+	ModuleHexEncode:                           "hexEncode",
+	ModuleHexDecode:                           "hexDecode",
+	ModuleBase64Encode:                        "base64Encode",
+	ModuleBase64Decode:                        "base64Decode",
+	ModuleBase64URLEncode:                     "base64URLEncode",
+	ModuleBase64URLDecode:                     "base64URLDecode",
+	ModuleJSONEncode:                          "jsonEncode",
+	ModuleJSONDecode:                          "jsonDecode",
+	ModuleCBOREncode:                          "cborEncode",
+	ModuleCBORDecode:                          "cborDecode",
+	ModuleHashSHA256:                          "hashSHA256",
+	ModuleHashSHA512:                          "hashSHA512",
+	ModuleHashBLAKE2b:                         "hashBLAKE2b",
+	ModuleHMACSHA256:                          "hmacSHA256",
+	ModuleHMACSHA512:                          "hmacSHA512",
+	ModuleEd25519GenerateKey:                  "ed25519GenerateKey",
+	ModuleEd25519PublicKey:                    "ed25519PublicKey",
+	ModuleEd25519Sign:                         "ed25519Sign",
+	ModuleEd25519Verify:                       "ed25519Verify",
+	ModuleConstantTimeCompare:                 "constantTimeCompare",
+	ModuleRandomBytes:                         "randomBytes",
+	ModulePBKDF2Key:                           "pbkdf2Key",
+	ModuleAESSeal:                             "aesSeal",
+	ModuleAESOpen:                             "aesOpen",
+	ModuleBoxGenerateKey:                      "boxGenerateKey",
+	ModuleBoxPublicKey:                        "boxPublicKey",
+	ModuleBoxSeal:                             "boxSeal",
+	ModuleBoxOpen:                             "boxOpen",
+	ModuleTreeName:                            "treeName",
+	ModuleTreeBytes:                           "treeBytes",
+	ModuleTreeHasRemaining:                    "treeHasRemaining",
+	ModuleTreeRemaining:                       "treeRemaining",
+	ModuleTreeFetch:                           "treeFetch",
+	ModuleTreeFetchAll:                        "treeFetchAll",
+	ModuleTreeChildren:                        "treeChildren",
+	ModuleQueryElement:                        "queryElement",
+	ModuleQueryToken:                          "queryToken",
+	ModuleQueryFetcher:                        "queryFetcher",
+	ModuleQueryInside:                         "queryInside",
+	ModuleQuery:                               "query",
+	ModuleQueryExecute:                        "queryExecute",
+}
+
+// Names returns the name of every module, by module index
+func Names() map[uint]string {
+	out := map[uint]string{}
+	for index, name := range moduleNames {
+		out[index] = name
+	}
+
+	return out
+}
+
+// Inputs returns the amount of inputs read by every module reading a fixed amount of inputs, by module index
+func Inputs() map[uint]uint {
+	out := map[uint]uint{}
+	for index, amount := range moduleInputs {
+		out[index] = amount
+	}
+
+	return out
+}
+
 // NewApplication creates a new virtual machine application
 func NewApplication(modulesFn vm_applications.FetchModulesFn) vm_applications.Application {
-	vmAppBuilder := vm_applications.NewBuilder(func(name []byte) string {
-		return string(name)
-	})
-
-	grammar := rodan_grammars.NewGrammar()
+	grammar := rodan_grammars.NewInstructionsGrammar()
 	query := queries.NewQuery()
-	vmApp, err := vmAppBuilder.Create().
-		WithFetchModulesFn(modulesFn).
-		WithGrammar(grammar).
-		WithQuery(query).
+	return newApplication(grammar, query, modulesFn, nil)
+}
+
+// NewApplicationBuilder creates a new application builder
+func NewApplicationBuilder() ApplicationBuilder {
+	return createApplicationBuilder()
+}
+
+// NewApplicationWithLimits creates a new virtual machine application whose interpretations are bounded by the provided limits
+func NewApplicationWithLimits(
+	basePath string,
+	chunkSize uint,
+	limits limits.Limits,
+) vm_applications.Application {
+	vmApp, err := NewApplicationBuilder().Create().
+		WithBasePath(basePath).
+		WithChunkSize(chunkSize).
+		WithLimits(limits).
 		Now()
 
 	if err != nil {
@@ -150,54 +963,119 @@ func NewVMModulesFuncs(
 	basePath string,
 	chunkSize uint,
 ) vm_applications.FetchModulesFn {
-	vmApp := NewApplication(newFetchModuleFunc(basePath, chunkSize))
-	vmModulesFn := createVM(vmApp).Execute()
-	baseModulesFn := newModulesFuncs(basePath, chunkSize)
-
-	// create the funcs list:
-	allModulesFuncs := map[uint]modules.ExecuteFn{}
-	for idx, fn := range vmModulesFn {
-		allModulesFuncs[idx] = fn
-	}
+	environment := newEnvironment(basePath, chunkSize, createMeter(nil), nil)
+	return environment.fetchModulesFn(nil)
+}
 
-	for idx, fn := range baseModulesFn {
-		allModulesFuncs[idx] = fn
+// ApplicationBuilder represents a virtual machine application builder
+type ApplicationBuilder interface {
+	Create() ApplicationBuilder
+	WithBasePath(basePath string) ApplicationBuilder
+	WithChunkSize(chunkSize uint) ApplicationBuilder
+	WithLimits(limits limits.Limits) ApplicationBuilder
+	WithCapabilities(capabilities capabilities.Capabilities) ApplicationBuilder
+	WithTracer(tracer traces.Tracer) ApplicationBuilder
+	WithEncryptionKey(encryptionKey []byte) ApplicationBuilder
+	Now() (vm_applications.Application, error)
+}
+
+func newApplication(
+	grammar grammars.Grammar,
+	query query_queries.Query,
+	modulesFn vm_applications.FetchModulesFn,
+	importFn interpreter_applications.ImportFn,
+) vm_applications.Application {
+	vmAppBuilder := vm_applications.NewBuilder(func(name []byte) string {
+		return string(name)
+	})
+
+	builder := vmAppBuilder.Create().
+		WithFetchModulesFn(modulesFn).
+		WithGrammar(grammar).
+		WithQuery(query)
+
+	if importFn != nil {
+		builder.WithImportFn(importFn)
 	}
 
-	modulesIns := newModules(allModulesFuncs)
-	return func() (modules.Modules, error) {
-		return modulesIns, nil
+	vmApp, err := builder.Now()
+
+	if err != nil {
+		panic(err)
 	}
+
+	return vmApp
 }
 
-func newFetchModuleFunc(
+func newEnvironment(
 	basePath string,
 	chunkSize uint,
-) vm_applications.FetchModulesFn {
-	fns := newModulesFuncs(basePath, chunkSize)
-	modulesIns := newModules(fns)
-	return func() (modules.Modules, error) {
-		return modulesIns, nil
+	meter *meter,
+	encryptionKey []byte,
+) *environment {
+	absBasePath, err := filepath.Abs(basePath)
+	if err != nil {
+		panic(err)
 	}
+
+	grammar := rodan_grammars.NewInstructionsGrammar()
+	query := queries.NewQuery()
+	return createEnvironment(grammar, query, absBasePath, chunkSize, meter, encryptionKey)
 }
 
 func newModulesFuncs(
-	basePath string,
+	absBasePath string,
 	chunkSize uint,
-) map[uint]modules.ExecuteFn {
+	meter *meter,
+	capabilities capabilities.Capabilities,
+	encryptionKey []byte,
+) map[uint]modules.ExecuteContextFn {
 	// create the containers module funcs:
 	containersFnsMap := createContainers().Execute()
 
 	// create the cast module funcs:
 	castFnsMap := createCast().Execute()
 
+	// create the map module funcs:
+	mapFnsMap := createMaps().Execute()
+
+	// create the arithmetic module funcs:
+	arithmeticFnsMap := createArithmetic().Execute()
+
+	// create the logic module funcs:
+	logicFnsMap := createLogic().Execute()
+
+	// create the text module funcs:
+	textFnsMap := createText().Execute()
+
+	// create the encoding module funcs:
+	encodingFnsMap := createEncoding().Execute()
+
+	// create the caught error module funcs:
+	caughtFnsMap := createCaught().Execute()
+
+	// create the tree module funcs:
+	treeFnsMap := createTree().Execute()
+
 	// create the file module funcs:
-	absBasePath, err := filepath.Abs(basePath)
-	if err != nil {
-		panic(err)
-	}
+	fileIns := createFile(absBasePath, chunkSize, meter, capabilities, encryptionKey)
+	fileFnsMap := fileIns.Execute()
+
+	// create the crypto module funcs, reading and writing their keys as files:
+	cryptoFnsMap := createCrypto(fileIns).Execute()
 
-	fileFnsMap := createFile(absBasePath, chunkSize).Execute()
+	// create the query module funcs:
+	queryFnsMap := createQuery(
+		query_applications.NewApplication(),
+		query_queries.NewBuilder(),
+		query_queries.NewQueryFnBuilder(),
+		query_queries.NewTokenBuilder(),
+		query_queries.NewElementBuilder(),
+		query_queries.NewInsideBuilder(),
+		query_queries.NewFetchersBuilder(),
+		query_queries.NewFetcherBuilder(),
+		query_queries.NewContentFnBuilder(),
+	).Execute()
 
 	// create the ast module funcs:
 	astApplication := applications.NewApplication()
@@ -238,32 +1116,68 @@ func newModulesFuncs(
 	).Execute()
 
 	// create the module funcs list:
-	moduleFuncs := map[uint]modules.ExecuteFn{}
+	moduleFuncs := map[uint]modules.ExecuteContextFn{}
 	for idx, fn := range containersFnsMap {
 		moduleFuncs[idx] = fn
 	}
 
 	for idx, fn := range castFnsMap {
-		moduleFuncs[idx] = fn
+		moduleFuncs[idx] = withContext(fn)
+	}
+
+	for idx, fn := range mapFnsMap {
+		moduleFuncs[idx] = withContext(fn)
+	}
+
+	for idx, fn := range arithmeticFnsMap {
+		moduleFuncs[idx] = withContext(fn)
+	}
+
+	for idx, fn := range logicFnsMap {
+		moduleFuncs[idx] = withContext(fn)
+	}
+
+	for idx, fn := range textFnsMap {
+		moduleFuncs[idx] = withContext(fn)
+	}
+
+	for idx, fn := range encodingFnsMap {
+		moduleFuncs[idx] = withContext(fn)
+	}
+
+	for idx, fn := range caughtFnsMap {
+		moduleFuncs[idx] = withContext(fn)
+	}
+
+	for idx, fn := range treeFnsMap {
+		moduleFuncs[idx] = withContext(fn)
 	}
 
 	for idx, fn := range fileFnsMap {
 		moduleFuncs[idx] = fn
 	}
 
-	for idx, fn := range grammarFnsMap {
+	for idx, fn := range cryptoFnsMap {
+		moduleFuncs[idx] = fn
+	}
+
+	for idx, fn := range queryFnsMap {
 		moduleFuncs[idx] = fn
 	}
 
+	for idx, fn := range grammarFnsMap {
+		moduleFuncs[idx] = withContext(fn)
+	}
+
 	return moduleFuncs
 }
 
-func newModules(moduleFuncs map[uint]modules.ExecuteFn) modules.Modules {
+func newModules(moduleFuncs map[uint]modules.ExecuteContextFn) modules.Modules {
 	// build the modules list:
 	modulesList := []modules.Module{}
 	moduleBuilder := modules.NewModuleBuilder()
 	for idx, oneFunc := range moduleFuncs {
-		ins, err := moduleBuilder.Create().WithIndex(uint(idx)).WithFunc(oneFunc).Now()
+		ins, err := moduleBuilder.Create().WithIndex(uint(idx)).WithContextFunc(oneFunc).Now()
 		if err != nil {
 			panic(err)
 		}
@@ -278,3 +1192,9 @@ func newModules(moduleFuncs map[uint]modules.ExecuteFn) modules.Modules {
 
 	return modulesIns
 }
+
+func withContext(fn modules.ExecuteFn) modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
+		return fn(input)
+	}
+}
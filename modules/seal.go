@@ -0,0 +1,133 @@
+package modules
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/nacl/box"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// sealKeySize represents the size of the keys derived from a passphrase or an exchange, in bytes, selecting AES-256
+const sealKeySize = 32
+
+// pbkdf2MinSaltSize represents the minimum size of the salts used to derive a key from a passphrase, in bytes
+const pbkdf2MinSaltSize = 16
+
+// pbkdf2MinIterations represents the minimum amount of iterations used to derive a key from a passphrase
+const pbkdf2MinIterations = 10000
+
+// pbkdf2MaxIterations represents the maximum amount of iterations used to derive a key from a passphrase, which bounds the duration of a derivation
+const pbkdf2MaxIterations = 10000000
+
+// boxKeySize represents the size of the private and public keys of the sealed boxes, in bytes
+const boxKeySize = 32
+
+// sealAES encrypts and authenticates the data with AES-GCM, returning the random nonce followed by the ciphertext
+func sealAES(key []byte, data []byte) ([]byte, error) {
+	aead, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	_, err = io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, data, nil), nil
+}
+
+// openAES authenticates then decrypts the data sealed by sealAES
+func openAES(key []byte, sealed []byte) ([]byte, error) {
+	aead, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < aead.NonceSize()+aead.Overhead() {
+		str := fmt.Sprintf("the sealed data was expected to contain at least %d bytes, %d provided", aead.NonceSize()+aead.Overhead(), len(sealed))
+		return nil, errors.New(str)
+	}
+
+	nonce := sealed[:aead.NonceSize()]
+	out, err := aead.Open(nil, nonce, sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, errors.New("the sealed data could not be opened: either the key is wrong or the data has been altered")
+	}
+
+	return out, nil
+}
+
+func newAESGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// generateBoxKey generates the private key of a sealed box recipient, then returns it along with its public key
+func generateBoxKey() ([]byte, []byte, error) {
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return privateKey[:], publicKey[:], nil
+}
+
+// boxPublicKey returns the public key of the private key of a sealed box recipient
+func boxPublicKey(privateKey []byte) ([]byte, error) {
+	if len(privateKey) != boxKeySize {
+		str := fmt.Sprintf("the private key of the sealed box was expected to contain %d bytes, %d provided", boxKeySize, len(privateKey))
+		return nil, errors.New(str)
+	}
+
+	return curve25519.X25519(privateKey, curve25519.Basepoint)
+}
+
+// sealBox seals the data for the recipient's public key as a NaCl anonymous sealed box (X25519, XSalsa20 and Poly1305),
+// so that only the recipient can open it, and no one, not even the sender, can identify the sender
+func sealBox(recipientPublicKey []byte, data []byte) ([]byte, error) {
+	if len(recipientPublicKey) != boxKeySize {
+		str := fmt.Sprintf("the public key of the sealed box recipient was expected to contain %d bytes, %d provided", boxKeySize, len(recipientPublicKey))
+		return nil, errors.New(str)
+	}
+
+	var publicKey [boxKeySize]byte
+	copy(publicKey[:], recipientPublicKey)
+	return box.SealAnonymous(nil, data, &publicKey, rand.Reader)
+}
+
+// openBox opens the data sealed by sealBox for the public key of the provided private key
+func openBox(recipientPrivateKey []byte, sealed []byte) ([]byte, error) {
+	recipientPublicKey, err := boxPublicKey(recipientPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var publicKey [boxKeySize]byte
+	var privateKey [boxKeySize]byte
+	copy(publicKey[:], recipientPublicKey)
+	copy(privateKey[:], recipientPrivateKey)
+	out, ok := box.OpenAnonymous(nil, sealed, &publicKey, &privateKey)
+	if !ok {
+		return nil, errors.New("the sealed box could not be opened: either the key is wrong or the data has been altered")
+	}
+
+	return out, nil
+}
+
+// pbkdf2SHA256 derives a key from a passphrase, as defined by the RFC 8018, using HMAC-SHA-256
+func pbkdf2SHA256(passphrase []byte, salt []byte, iterations uint, length int) []byte {
+	return pbkdf2.Key(passphrase, salt, int(iterations), length, sha256.New)
+}
@@ -0,0 +1,466 @@
+package modules
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSeal_pbkdf2_Success(t *testing.T) {
+	testCases := []struct {
+		iterations uint
+		expected   string
+	}{
+		{iterations: 1, expected: "120fb6cffcf8b32c43e7225256c4f837a86548c92ccc35480805987cb70be17b"},
+		{iterations: 4096, expected: "c5e478d59288c841aa530db6845c4c8d962893a001ce4e11a4963873aa98134a"},
+	}
+
+	for _, oneTestCase := range testCases {
+		key := pbkdf2SHA256([]byte("password"), []byte("salt"), oneTestCase.iterations, sealKeySize)
+		if hex.EncodeToString(key) != oneTestCase.expected {
+			t.Errorf("%d iterations: the key was expected to be %s, %x returned", oneTestCase.iterations, oneTestCase.expected, key)
+		}
+	}
+}
+
+func TestSeal_boxPublicKey_Success(t *testing.T) {
+	// the key pair of Alice, from the RFC 7748:
+	privateKey, _ := hex.DecodeString("77076d0a7318a57d3c16c17251b26645df4c2f87ebc0992ab177fba51db92c2a")
+	expected := "8520f0098930a754748b7ddcb43ef75a0dbf3a0d26381af4eba4a98eaa9b4e6a"
+	publicKey, err := boxPublicKey(privateKey)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if hex.EncodeToString(publicKey) != expected {
+		t.Errorf("the public key was expected to be %s, %x returned", expected, publicKey)
+		return
+	}
+}
+
+func TestSeal_modules_Success(t *testing.T) {
+	ctx := context.Background()
+	fns := createCrypto(createFile(os.TempDir(), 1024, createMeter(nil), nil, nil)).Execute()
+
+	// the passphrase derivation refuses the weak parameters:
+	_, err := fns[ModulePBKDF2Key](ctx, map[uint]interface{}{
+		0: []byte("password"),
+		1: []byte("salt"),
+		2: uint(pbkdf2MinIterations),
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	salt, err := fns[ModuleRandomBytes](ctx, map[uint]interface{}{
+		0: uint(pbkdf2MinSaltSize),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = fns[ModulePBKDF2Key](ctx, map[uint]interface{}{
+		0: []byte("password"),
+		1: salt,
+		2: uint(pbkdf2MaxIterations + 1),
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	key, err := fns[ModulePBKDF2Key](ctx, map[uint]interface{}{
+		0: []byte("password"),
+		1: salt,
+		2: uint(pbkdf2MinIterations),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	data := []byte("this is some private data")
+	sealed, err := fns[ModuleAESSeal](ctx, map[uint]interface{}{
+		0: key,
+		1: data,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	opened, err := fns[ModuleAESOpen](ctx, map[uint]interface{}{
+		0: key,
+		1: sealed,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Equal(opened.([]byte), data) {
+		t.Errorf("the opened data was expected to be '%s', '%s' returned", data, opened)
+		return
+	}
+
+	// the altered data is refused:
+	tampered := append([]byte{}, sealed.([]byte)...)
+	tampered[len(tampered)-1] ^= 1
+	_, err = fns[ModuleAESOpen](ctx, map[uint]interface{}{
+		0: key,
+		1: tampered,
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	// the data cannot be opened with another key:
+	otherKey, err := fns[ModuleRandomBytes](ctx, map[uint]interface{}{
+		0: uint(sealKeySize),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = fns[ModuleAESOpen](ctx, map[uint]interface{}{
+		0: otherKey,
+		1: sealed,
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestSeal_box_Success(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	ctx := context.Background()
+	fns := createCrypto(createFile(basePath, 1024, createMeter(nil), nil, nil)).Execute()
+	publicKey, err := fns[ModuleBoxGenerateKey](ctx, map[uint]interface{}{
+		0: []byte("recipient.key"),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = fns[ModuleBoxGenerateKey](ctx, map[uint]interface{}{
+		0: []byte("other.key"),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	storedPublicKey, err := fns[ModuleBoxPublicKey](ctx, map[uint]interface{}{
+		0: []byte("recipient.key"),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Equal(storedPublicKey.([]byte), publicKey.([]byte)) {
+		t.Errorf("the public key of the stored key was expected to be the generated public key")
+		return
+	}
+
+	data := []byte("this is a message to the recipient")
+	sealed, err := fns[ModuleBoxSeal](ctx, map[uint]interface{}{
+		0: publicKey,
+		1: data,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	opened, err := fns[ModuleBoxOpen](ctx, map[uint]interface{}{
+		0: []byte("recipient.key"),
+		1: sealed,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Equal(opened.([]byte), data) {
+		t.Errorf("the opened data was expected to be '%s', '%s' returned", data, opened)
+		return
+	}
+
+	// only the recipient can open the box:
+	_, err = fns[ModuleBoxOpen](ctx, map[uint]interface{}{
+		0: []byte("other.key"),
+		1: sealed,
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	// the invalid public keys are refused:
+	_, err = fns[ModuleBoxSeal](ctx, map[uint]interface{}{
+		0: []byte("invalid"),
+		1: data,
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestSeal_encryptedFile_Success(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	path := filepath.Join(basePath, "private.data")
+	pConn, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer pConn.Close()
+	ctx := context.Background()
+	encryptionKey := bytes.Repeat([]byte{7}, sealKeySize)
+	fileIns := createFile(basePath, 1024, createMeter(nil), nil, encryptionKey)
+	fns := fileIns.Execute()
+	data := []byte("this is some data encrypted at rest")
+	for _, oneData := range [][]byte{append(data, data...), data} {
+		written, err := fns[ModuleFileWrite](ctx, map[uint]interface{}{
+			0: pConn,
+			1: oneData,
+		})
+
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if written.(int) != len(oneData) {
+			t.Errorf("%d bytes were expected to be written, %d returned", len(oneData), written)
+			return
+		}
+	}
+
+	// the file only contains the last sealed data:
+	stored, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if bytes.Contains(stored, data) {
+		t.Errorf("the file was expected to not contain the data in plain text")
+		return
+	}
+
+	opened, err := openAES(encryptionKey, stored)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Equal(opened, data) {
+		t.Errorf("the stored data was expected to be '%s', '%s' returned", data, opened)
+		return
+	}
+
+	read, err := fns[ModuleFileRead](ctx, map[uint]interface{}{
+		0: pConn,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Equal(read.([]byte), data) {
+		t.Errorf("the read data was expected to be '%s', '%s' returned", data, read)
+		return
+	}
+
+	// the encrypted files can only be read and written as a whole:
+	_, err = fns[ModuleFileRead](ctx, map[uint]interface{}{
+		0: pConn,
+		1: uint(4),
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	_, err = fns[ModuleFileWrite](ctx, map[uint]interface{}{
+		0: pConn,
+		1: data,
+		2: uint(4),
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	// the keys are encrypted at rest as well:
+	cryptoFns := createCrypto(fileIns).Execute()
+	publicKey, err := cryptoFns[ModuleBoxGenerateKey](ctx, map[uint]interface{}{
+		0: []byte("recipient.key"),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	storedKey, err := ioutil.ReadFile(filepath.Join(basePath, "recipient.key"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if _, err := openAES(encryptionKey, storedKey); err != nil {
+		t.Errorf("the stored key was expected to be sealed, error returned: %s", err.Error())
+		return
+	}
+
+	storedPublicKey, err := cryptoFns[ModuleBoxPublicKey](ctx, map[uint]interface{}{
+		0: []byte("recipient.key"),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Equal(storedPublicKey.([]byte), publicKey.([]byte)) {
+		t.Errorf("the public key of the stored key was expected to be the generated public key")
+		return
+	}
+}
+
+func TestSeal_encryptedFile_throughScript_Success(t *testing.T) {
+	basePath, err := ioutil.TempDir("", "rodan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer os.RemoveAll(basePath)
+	encryptionKey := bytes.Repeat([]byte{7}, sealKeySize)
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(basePath).
+		WithChunkSize(1024).
+		WithEncryptionKey(encryptionKey).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		module @fileOpen:2;;
+		module @fileClose:3;;
+		module @fileRead:7;;
+		module @fileWrite:8;;
+		module @fileCreate:137;;
+
+		-> $path;;
+		-> $data;;
+		<- $output;;
+
+		$zero = 0;;
+		$writeConn = @fileCreate($path);;
+		@fileWrite($writeConn, $data, $zero);;
+		@fileClose($writeConn);;
+
+		$readConn = @fileOpen($path);;
+		$output = @fileRead($readConn);;
+		@fileClose($readConn);;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	data := []byte("this is some data encrypted at rest")
+	output, err := application.Interpret([]interface{}{[]byte("private.data"), data}, program)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Equal(output[0].([]byte), data) {
+		t.Errorf("the read data was expected to be '%s', '%s' returned", data, output[0])
+		return
+	}
+
+	stored, err := ioutil.ReadFile(filepath.Join(basePath, "private.data"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if bytes.Contains(stored, data) {
+		t.Errorf("the file was expected to not contain the data in plain text")
+		return
+	}
+}
+
+func TestSeal_applicationBuilder_withInvalidEncryptionKey_returnsError(t *testing.T) {
+	_, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		WithEncryptionKey([]byte("invalid")).
+		Now()
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
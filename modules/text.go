@@ -0,0 +1,311 @@
+package modules
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/steve-care-software/interpreter/domain/programs/modules"
+)
+
+type text struct {
+}
+
+func createText() *text {
+	out := text{}
+	return &out
+}
+
+// Execute executes the application
+func (app *text) Execute() map[uint]modules.ExecuteFn {
+	concat := app.concat()
+	split := app.split()
+	join := app.join()
+	trim := app.trim()
+	trimPrefix := app.trimPrefix()
+	trimSuffix := app.trimSuffix()
+	contains := app.contains()
+	index := app.index()
+	replace := app.replace()
+	slice := app.slice()
+	upper := app.upper()
+	lower := app.lower()
+	length := app.length()
+	return map[uint]modules.ExecuteFn{
+		ModuleTextConcat:     concat,
+		ModuleTextSplit:      split,
+		ModuleTextJoin:       join,
+		ModuleTextTrim:       trim,
+		ModuleTextTrimPrefix: trimPrefix,
+		ModuleTextTrimSuffix: trimSuffix,
+		ModuleTextContains:   contains,
+		ModuleTextIndex:      index,
+		ModuleTextReplace:    replace,
+		ModuleTextSlice:      slice,
+		ModuleTextUpper:      upper,
+		ModuleTextLower:      lower,
+		ModuleTextLength:     length,
+	}
+}
+
+func (app *text) concat() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		first, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		second, err := inputText(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		out := make([]byte, 0, len(first)+len(second))
+		out = append(out, first...)
+		return append(out, second...), nil
+	}
+}
+
+func (app *text) split() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		value, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		separator, err := inputText(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		// an empty separator splits the text after every UTF-8 character:
+		out := []interface{}{}
+		for _, onePart := range bytes.Split(value, separator) {
+			out = append(out, copyBytes(onePart))
+		}
+
+		return out, nil
+	}
+}
+
+func (app *text) join() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		list, err := inputList(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		separator, err := inputText(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		parts := [][]byte{}
+		for idx, oneElement := range list {
+			part, ok := toText(oneElement)
+			if !ok {
+				str := fmt.Sprintf("the element at index %d was expected to contain bytes, %T provided", idx, oneElement)
+				return nil, errors.New(str)
+			}
+
+			parts = append(parts, []byte(part))
+		}
+
+		return bytes.Join(parts, separator), nil
+	}
+}
+
+func (app *text) trim() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		value, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		return copyBytes(bytes.TrimSpace(value)), nil
+	}
+}
+
+func (app *text) trimPrefix() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		value, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		prefix, err := inputText(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		return copyBytes(bytes.TrimPrefix(value, prefix)), nil
+	}
+}
+
+func (app *text) trimSuffix() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		value, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		suffix, err := inputText(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		return copyBytes(bytes.TrimSuffix(value, suffix)), nil
+	}
+}
+
+func (app *text) contains() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		value, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		search, err := inputText(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		return bytes.Contains(value, search), nil
+	}
+}
+
+func (app *text) index() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		value, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		search, err := inputText(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		index := bytes.Index(value, search)
+		if index < 0 {
+			str := fmt.Sprintf("the text (%s) could not be found in the text (%s)", search, value)
+			return nil, errors.New(str)
+		}
+
+		return uint(index), nil
+	}
+}
+
+func (app *text) replace() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		value, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		old, err := inputText(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		// an empty value would match between every byte, multiplying the size of the text by the size of the replacement:
+		if len(old) <= 0 {
+			str := fmt.Sprintf("the input at index %d was expected to contain the non-empty bytes to replace", 1)
+			return nil, errors.New(str)
+		}
+
+		replacement, err := inputText(input, 2)
+		if err != nil {
+			return nil, err
+		}
+
+		return bytes.ReplaceAll(value, old, replacement), nil
+	}
+}
+
+func (app *text) slice() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		value, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		from, err := inputUint(input, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		to, err := inputUint(input, 2)
+		if err != nil {
+			return nil, err
+		}
+
+		if from > to {
+			str := fmt.Sprintf("the bytes could not be sliced because their start index (%d) is greater than their end index (%d)", from, to)
+			return nil, errors.New(str)
+		}
+
+		amount := uint(len(value))
+		if to > amount {
+			str := fmt.Sprintf("the bytes could not be sliced to index %d because they only contain %d bytes", to, amount)
+			return nil, errors.New(str)
+		}
+
+		return copyBytes(value[from:to]), nil
+	}
+}
+
+func (app *text) upper() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		value, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		return bytes.ToUpper(value), nil
+	}
+}
+
+func (app *text) lower() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		value, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		return bytes.ToLower(value), nil
+	}
+}
+
+// length returns the amount of UTF-8 characters, every invalid byte being counted as one character
+func (app *text) length() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		value, err := inputText(input, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		return uint(utf8.RuneCount(value)), nil
+	}
+}
+
+func inputText(input map[uint]interface{}, index uint) ([]byte, error) {
+	if value, ok := input[index].([]byte); ok {
+		return value, nil
+	}
+
+	if value, ok := input[index].(string); ok {
+		return []byte(value), nil
+	}
+
+	str := fmt.Sprintf("the input at index %d was expected to contain bytes", index)
+	return nil, errors.New(str)
+}
+
+// copyBytes copies the bytes, so that a value sliced from an input never shares its memory with it
+func copyBytes(value []byte) []byte {
+	out := make([]byte, len(value))
+	copy(out, value)
+	return out
+}
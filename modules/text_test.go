@@ -0,0 +1,159 @@
+package modules
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestText_Success(t *testing.T) {
+	testCases := []struct {
+		name     string
+		module   uint
+		input    []interface{}
+		expected interface{}
+		isError  bool
+	}{
+		{name: "concat", module: ModuleTextConcat, input: []interface{}{[]byte("my"), []byte("Token")}, expected: []byte("myToken")},
+		{name: "concat, string", module: ModuleTextConcat, input: []interface{}{"my", []byte("Token")}, expected: []byte("myToken")},
+		{name: "concat, not bytes", module: ModuleTextConcat, input: []interface{}{[]byte("my"), uint(1)}, isError: true},
+		{name: "split", module: ModuleTextSplit, input: []interface{}{[]byte("a,b,,c"), []byte(",")}, expected: []interface{}{[]byte("a"), []byte("b"), []byte(""), []byte("c")}},
+		{name: "split, empty separator", module: ModuleTextSplit, input: []interface{}{[]byte("aé"), []byte("")}, expected: []interface{}{[]byte("a"), []byte("é")}},
+		{name: "join", module: ModuleTextJoin, input: []interface{}{[]interface{}{[]byte("a"), "b"}, []byte("\n")}, expected: []byte("a\nb")},
+		{name: "join, empty", module: ModuleTextJoin, input: []interface{}{[]interface{}{}, []byte(",")}, expected: []byte{}},
+		{name: "join, not bytes", module: ModuleTextJoin, input: []interface{}{[]interface{}{uint(1)}, []byte(",")}, isError: true},
+		{name: "trim", module: ModuleTextTrim, input: []interface{}{[]byte("\t value \n")}, expected: []byte("value")},
+		{name: "trimPrefix", module: ModuleTextTrimPrefix, input: []interface{}{[]byte("$name"), []byte("$")}, expected: []byte("name")},
+		{name: "trimPrefix, missing", module: ModuleTextTrimPrefix, input: []interface{}{[]byte("name"), []byte("$")}, expected: []byte("name")},
+		{name: "trimSuffix", module: ModuleTextTrimSuffix, input: []interface{}{[]byte("file.rodan"), []byte(".rodan")}, expected: []byte("file")},
+		{name: "contains", module: ModuleTextContains, input: []interface{}{[]byte("grammar"), []byte("mm")}, expected: true},
+		{name: "contains, missing", module: ModuleTextContains, input: []interface{}{[]byte("grammar"), []byte("x")}, expected: false},
+		{name: "index", module: ModuleTextIndex, input: []interface{}{[]byte("grammar"), []byte("mm")}, expected: uint(3)},
+		{name: "index, missing", module: ModuleTextIndex, input: []interface{}{[]byte("grammar"), []byte("x")}, isError: true},
+		{name: "replace", module: ModuleTextReplace, input: []interface{}{[]byte("a-b-c"), []byte("-"), []byte("::")}, expected: []byte("a::b::c")},
+		{name: "replace, missing input", module: ModuleTextReplace, input: []interface{}{[]byte("a-b-c"), []byte("-")}, isError: true},
+		{name: "replace, empty old", module: ModuleTextReplace, input: []interface{}{[]byte("a-b-c"), []byte(""), []byte("::")}, isError: true},
+		{name: "slice", module: ModuleTextSlice, input: []interface{}{[]byte("grammar"), uint(1), uint(4)}, expected: []byte("ram")},
+		{name: "slice, empty", module: ModuleTextSlice, input: []interface{}{[]byte("grammar"), uint(7), uint(7)}, expected: []byte{}},
+		{name: "slice, inverted bounds", module: ModuleTextSlice, input: []interface{}{[]byte("grammar"), uint(4), uint(1)}, isError: true},
+		{name: "slice, out of bounds", module: ModuleTextSlice, input: []interface{}{[]byte("grammar"), uint(1), uint(8)}, isError: true},
+		{name: "slice, signed index", module: ModuleTextSlice, input: []interface{}{[]byte("grammar"), 1, uint(2)}, isError: true},
+		{name: "upper", module: ModuleTextUpper, input: []interface{}{[]byte("myToken")}, expected: []byte("MYTOKEN")},
+		{name: "lower", module: ModuleTextLower, input: []interface{}{[]byte("MyToken")}, expected: []byte("mytoken")},
+		{name: "length", module: ModuleTextLength, input: []interface{}{[]byte("héllo")}, expected: uint(5)},
+		{name: "length, invalid UTF-8", module: ModuleTextLength, input: []interface{}{[]byte{0xff, 'a'}}, expected: uint(2)},
+		{name: "length, not bytes", module: ModuleTextLength, input: []interface{}{[]interface{}{}}, isError: true},
+	}
+
+	fns := createText().Execute()
+	for _, oneTestCase := range testCases {
+		input := map[uint]interface{}{}
+		for idx, oneInput := range oneTestCase.input {
+			input[uint(idx)] = oneInput
+		}
+
+		output, err := fns[oneTestCase.module](input)
+		if oneTestCase.isError {
+			if err == nil {
+				t.Errorf("%s: the error was expected to be valid, nil returned", oneTestCase.name)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: the error was expected to be nil, error returned: %s", oneTestCase.name, err.Error())
+			continue
+		}
+
+		if !reflect.DeepEqual(output, oneTestCase.expected) {
+			t.Errorf("%s: the output was expected to be %v, %v returned", oneTestCase.name, oneTestCase.expected, output)
+			continue
+		}
+	}
+}
+
+func TestText_slicesAreCopied_Success(t *testing.T) {
+	value := []byte("  data  ")
+	output, err := createText().Execute()[ModuleTextTrim](map[uint]interface{}{
+		0: value,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	value[2] = 'D'
+	if string(output.([]byte)) != "data" {
+		t.Errorf("the trimmed bytes were expected to not share their memory with the provided bytes")
+		return
+	}
+
+	value = []byte("a,b")
+	output, err = createText().Execute()[ModuleTextSplit](map[uint]interface{}{
+		0: value,
+		1: []byte(","),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	value[0] = 'A'
+	if string(output.([]interface{})[0].([]byte)) != "a" {
+		t.Errorf("the split parts were expected to not share their memory with the provided bytes")
+		return
+	}
+}
+
+func TestText_withTokenName_Success(t *testing.T) {
+	script := `
+		module @textTrimSuffix:89;;
+		module @textConcat:84;;
+
+		-> $path;;
+		<- $tokenName;;
+
+		$extension = ".rodan";;
+		$prefix = "token_";;
+		$tokenName = @textConcat($prefix, @textTrimSuffix($path, $extension));;
+	`
+
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	output, err := application.Interpret([]interface{}{
+		[]byte("any_letter.rodan"),
+	}, program)
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if string(output[0].([]byte)) != "token_any_letter" {
+		t.Errorf("the token name was expected to be '%s', '%s' returned", "token_any_letter", output[0])
+		return
+	}
+}
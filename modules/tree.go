@@ -0,0 +1,204 @@
+package modules
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/steve-care-software/ast/domain/trees"
+	"github.com/steve-care-software/interpreter/domain/programs/modules"
+)
+
+type tree struct {
+}
+
+func createTree() *tree {
+	out := tree{}
+	return &out
+}
+
+// Execute executes the application
+func (app *tree) Execute() map[uint]modules.ExecuteFn {
+	return map[uint]modules.ExecuteFn{
+		ModuleTreeName:         app.treeName(),
+		ModuleTreeBytes:        app.treeBytes(),
+		ModuleTreeHasRemaining: app.treeHasRemaining(),
+		ModuleTreeRemaining:    app.treeRemaining(),
+		ModuleTreeFetch:        app.treeFetch(),
+		ModuleTreeFetchAll:     app.treeFetchAll(),
+		ModuleTreeChildren:     app.treeChildren(),
+	}
+}
+
+func (app *tree) treeName() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		tree, err := app.fetchTree(input)
+		if err != nil {
+			return nil, err
+		}
+
+		return []byte(tree.Grammar().Name()), nil
+	}
+}
+
+func (app *tree) treeBytes() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		tree, err := app.fetchTree(input)
+		if err != nil {
+			return nil, err
+		}
+
+		if includeChannels, ok := input[1].(bool); ok {
+			return tree.Bytes(includeChannels), nil
+		}
+
+		str := fmt.Sprintf("the input at index (%d) was expected to contain a bool", 1)
+		return nil, errors.New(str)
+	}
+}
+
+func (app *tree) treeHasRemaining() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		tree, err := app.fetchTree(input)
+		if err != nil {
+			return nil, err
+		}
+
+		return tree.HasRemaining(), nil
+	}
+}
+
+func (app *tree) treeRemaining() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		tree, err := app.fetchTree(input)
+		if err != nil {
+			return nil, err
+		}
+
+		if !tree.HasRemaining() {
+			str := fmt.Sprintf("the tree (token: %s) does not contain remaining data", tree.Grammar().Name())
+			return nil, errors.New(str)
+		}
+
+		return copyBytes(tree.Remaining()), nil
+	}
+}
+
+func (app *tree) treeFetch() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		tree, name, err := app.fetchTreeAndName(input)
+		if err != nil {
+			return nil, err
+		}
+
+		found := app.descendants(tree, name, true)
+		if len(found) <= 0 {
+			str := fmt.Sprintf("the tree (token: %s) does not contain a token named %s", tree.Grammar().Name(), name)
+			return nil, errors.New(str)
+		}
+
+		return found[0], nil
+	}
+}
+
+func (app *tree) treeFetchAll() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		tree, name, err := app.fetchTreeAndName(input)
+		if err != nil {
+			return nil, err
+		}
+
+		out := []interface{}{}
+		for _, oneTree := range app.descendants(tree, name, false) {
+			out = append(out, oneTree)
+		}
+
+		return out, nil
+	}
+}
+
+func (app *tree) treeChildren() modules.ExecuteFn {
+	return func(input map[uint]interface{}) (interface{}, error) {
+		tree, err := app.fetchTree(input)
+		if err != nil {
+			return nil, err
+		}
+
+		out := []interface{}{}
+		for _, oneChild := range app.children(tree) {
+			out = append(out, oneChild)
+		}
+
+		return out, nil
+	}
+}
+
+// descendants returns the trees nested in the tree whose token is named after the name, in the order they were matched, a matched tree preceding the trees nested in it
+func (app *tree) descendants(tree trees.Tree, name string, isFirstOnly bool) []trees.Tree {
+	out := []trees.Tree{}
+	for _, oneChild := range app.children(tree) {
+		if oneChild.Grammar().Name() == name {
+			out = append(out, oneChild)
+			if isFirstOnly {
+				return out
+			}
+		}
+
+		out = append(out, app.descendants(oneChild, name, isFirstOnly)...)
+		if isFirstOnly && len(out) > 0 {
+			return out
+		}
+	}
+
+	return out
+}
+
+// children returns the trees matched by the successful line of the tree, the channels excluded
+func (app *tree) children(tree trees.Tree) []trees.Tree {
+	out := []trees.Tree{}
+	if !tree.Block().HasSuccessful() {
+		return out
+	}
+
+	line := tree.Block().Successful()
+	if !line.HasElements() {
+		return out
+	}
+
+	for _, oneElement := range line.Elements().List() {
+		for _, oneContent := range oneElement.Contents().List() {
+			if oneContent.IsTree() {
+				out = append(out, oneContent.Tree())
+			}
+		}
+	}
+
+	return out
+}
+
+func (app *tree) fetchTreeAndName(input map[uint]interface{}) (trees.Tree, string, error) {
+	tree, err := app.fetchTree(input)
+	if err != nil {
+		return nil, "", err
+	}
+
+	name, err := inputText(input, 1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return tree, string(name), nil
+}
+
+func (app *tree) fetchTree(input map[uint]interface{}) (trees.Tree, error) {
+	if ins, ok := input[0]; ok {
+		if casted, ok := ins.(trees.Tree); ok {
+			return casted, nil
+		}
+
+		str := fmt.Sprintf("the value was expected to contain a tree, %T provided", ins)
+		return nil, errors.New(str)
+	}
+
+	str := fmt.Sprintf("the value was expected to be valid")
+	return nil, errors.New(str)
+}
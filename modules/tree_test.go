@@ -0,0 +1,263 @@
+package modules
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/steve-care-software/ast/applications"
+	"github.com/steve-care-software/ast/domain/trees"
+	"github.com/steve-care-software/interpreter/domain/programs/modules"
+	rodan_grammars "github.com/steve-care-software/rodan/grammars"
+)
+
+func executeTree(t *testing.T, script string) trees.Tree {
+	tree, err := applications.NewApplication().Execute(rodan_grammars.NewInstructionsGrammar(), []byte(script))
+	if err != nil {
+		t.Fatalf("the error was expected to be nil, error returned: %s", err.Error())
+	}
+
+	return tree
+}
+
+func treeContents(t *testing.T, fns map[uint]modules.ExecuteFn, list interface{}) []string {
+	out := []string{}
+	for _, oneTree := range list.([]interface{}) {
+		content, err := fns[ModuleTreeBytes](map[uint]interface{}{
+			0: oneTree,
+			1: false,
+		})
+
+		if err != nil {
+			t.Fatalf("the error was expected to be nil, error returned: %s", err.Error())
+		}
+
+		out = append(out, string(content.([]byte)))
+	}
+
+	return out
+}
+
+func TestTree_Success(t *testing.T) {
+	fns := createTree().Execute()
+	tree := executeTree(t, "$first = $input;;\n$second = $first;;\n")
+	name, err := fns[ModuleTreeName](map[uint]interface{}{
+		0: tree,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if string(name.([]byte)) != tree.Grammar().Name() {
+		t.Errorf("the name was expected to be '%s', '%s' returned", tree.Grammar().Name(), name)
+		return
+	}
+
+	withChannels, err := fns[ModuleTreeBytes](map[uint]interface{}{
+		0: tree,
+		1: true,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if string(withChannels.([]byte)) != "$first = $input;;\n$second = $first;;\n" {
+		t.Errorf("the bytes were expected to contain the channels, '%s' returned", withChannels)
+		return
+	}
+
+	withoutChannels, err := fns[ModuleTreeBytes](map[uint]interface{}{
+		0: tree,
+		1: false,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if string(withoutChannels.([]byte)) != "$first=$input;;$second=$first;;" {
+		t.Errorf("the bytes were expected to exclude the channels, '%s' returned", withoutChannels)
+		return
+	}
+
+	instruction, err := fns[ModuleTreeFetch](map[uint]interface{}{
+		0: tree,
+		1: []byte("instruction"),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	references, err := fns[ModuleTreeFetchAll](map[uint]interface{}{
+		0: instruction,
+		1: []byte("variableReference"),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := []string{"$first", "$input"}
+	if contents := treeContents(t, fns, references); !reflect.DeepEqual(contents, expected) {
+		t.Errorf("the references were expected to be %v, %v returned", expected, contents)
+		return
+	}
+
+	references, err = fns[ModuleTreeFetchAll](map[uint]interface{}{
+		0: tree,
+		1: []byte("variableReference"),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected = []string{"$first", "$input", "$second", "$first"}
+	if contents := treeContents(t, fns, references); !reflect.DeepEqual(contents, expected) {
+		t.Errorf("the references were expected to be %v, %v returned", expected, contents)
+		return
+	}
+
+	// the children are the trees matched directly by the tree:
+	children, err := fns[ModuleTreeChildren](map[uint]interface{}{
+		0: tree,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	names := []string{}
+	for _, oneChild := range children.([]interface{}) {
+		names = append(names, oneChild.(trees.Tree).Grammar().Name())
+	}
+
+	expected = []string{"instruction", "instruction"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("the children were expected to be %v, %v returned", expected, names)
+		return
+	}
+
+	// a missing token cannot be fetched, but fetching all of them returns an empty list:
+	_, err = fns[ModuleTreeFetch](map[uint]interface{}{
+		0: tree,
+		1: []byte("missing"),
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	missing, err := fns[ModuleTreeFetchAll](map[uint]interface{}{
+		0: tree,
+		1: []byte("missing"),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(missing.([]interface{})) != 0 {
+		t.Errorf("the list was expected to be empty, %v returned", missing)
+		return
+	}
+
+	hasRemaining, err := fns[ModuleTreeHasRemaining](map[uint]interface{}{
+		0: tree,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if hasRemaining.(bool) {
+		t.Errorf("the tree was expected to not contain remaining data")
+		return
+	}
+
+	_, err = fns[ModuleTreeRemaining](map[uint]interface{}{
+		0: tree,
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestTree_withRemaining_Success(t *testing.T) {
+	fns := createTree().Execute()
+	tree := executeTree(t, "$first = $input;;\n~~~")
+	hasRemaining, err := fns[ModuleTreeHasRemaining](map[uint]interface{}{
+		0: tree,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !hasRemaining.(bool) {
+		t.Errorf("the tree was expected to contain remaining data")
+		return
+	}
+
+	remaining, err := fns[ModuleTreeRemaining](map[uint]interface{}{
+		0: tree,
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if string(remaining.([]byte)) != "~~~" {
+		t.Errorf("the remaining data was expected to be '%s', '%s' returned", "~~~", remaining)
+		return
+	}
+}
+
+func TestTree_withoutTree_returnsError(t *testing.T) {
+	fns := createTree().Execute()
+	for _, oneModule := range []uint{ModuleTreeName, ModuleTreeHasRemaining, ModuleTreeRemaining, ModuleTreeChildren} {
+		_, err := fns[oneModule](map[uint]interface{}{
+			0: []byte("not a tree"),
+		})
+
+		if err == nil {
+			t.Errorf("module %d: the error was expected to be valid, nil returned", oneModule)
+		}
+	}
+
+	tree := executeTree(t, "$first = $input;;\n")
+	_, err := fns[ModuleTreeBytes](map[uint]interface{}{
+		0: tree,
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	_, err = fns[ModuleTreeFetch](map[uint]interface{}{
+		0: tree,
+		1: uint(1),
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
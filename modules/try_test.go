@@ -0,0 +1,340 @@
+package modules
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	interpreter_applications "github.com/steve-care-software/interpreter/applications"
+	"github.com/steve-care-software/rodan/limits"
+)
+
+func TestTry_Success(t *testing.T) {
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		module @castToInt:9;;
+		module @errorMessage:36;;
+		module @errorHasModule:37;;
+		module @errorModuleName:38;;
+		module @errorModuleIndex:39;;
+		module @errorPosition:40;;
+
+		-> $value;;
+		<- $number;;
+		<- $message;;
+		<- $hasModule;;
+		<- $moduleName;;
+		<- $moduleIndex;;
+		<- $position;;
+
+		$number = -1;;
+		try {
+			$isValid = true;;
+			$number = @castToInt($value);;
+		} catch $err {
+			$message = @errorMessage($err);;
+			$hasModule = @errorHasModule($err);;
+			$moduleName = @errorModuleName($err);;
+			$moduleIndex = @errorModuleIndex($err);;
+			$position = @errorPosition($err);;
+		};;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	output, err := application.Interpret([]interface{}{
+		"invalid",
+	}, program)
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(output) != 6 {
+		t.Errorf("%d output was expected, %d returned", 6, len(output))
+		return
+	}
+
+	if output[0].(int) != -1 {
+		t.Errorf("the number was expected to be %d, %d returned", -1, output[0])
+		return
+	}
+
+	expectedMessage := `the value (invalid) could not be parsed as an integer: invalid syntax`
+	if string(output[1].([]byte)) != expectedMessage {
+		t.Errorf("the message was expected to be '%s', '%s' returned", expectedMessage, output[1])
+		return
+	}
+
+	if !output[2].(bool) {
+		t.Errorf("the error was expected to be raised by a module")
+		return
+	}
+
+	if string(output[3].([]byte)) != "castToInt" {
+		t.Errorf("the module name was expected to be '%s', '%s' returned", "castToInt", output[3])
+		return
+	}
+
+	if output[4].(uint) != ModuleCastToInt {
+		t.Errorf("the module index was expected to be %d, %d returned", ModuleCastToInt, output[4])
+		return
+	}
+
+	// the try is the second executed instruction, the call the second executed instruction of the try:
+	position := output[5].([]interface{})
+	expectedPosition := []uint{1, 1}
+	if len(position) != len(expectedPosition) {
+		t.Errorf("the position was expected to contain %d indexes, %d returned", len(expectedPosition), len(position))
+		return
+	}
+
+	for idx, oneIndex := range position {
+		if oneIndex.(uint) != expectedPosition[idx] {
+			t.Errorf("the position's index (index: %d) was expected to be %d, %d returned", idx, expectedPosition[idx], oneIndex)
+			return
+		}
+	}
+}
+
+func TestTry_withFail_Success(t *testing.T) {
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		module @errorMessage:36;;
+		module @errorHasModule:37;;
+
+		<- $message;;
+		<- $hasModule;;
+
+		try {
+			fail "the value is invalid";;
+		} catch $err {
+			$message = @errorMessage($err);;
+			$hasModule = @errorHasModule($err);;
+		};;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	output, err := application.Interpret([]interface{}{}, program)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if string(output[0].([]byte)) != "the value is invalid" {
+		t.Errorf("the message was expected to be '%s', '%s' returned", "the value is invalid", output[0])
+		return
+	}
+
+	if output[1].(bool) {
+		t.Errorf("the error was expected to not be raised by a module")
+		return
+	}
+}
+
+func TestTry_failWithCaughtError_returnsError(t *testing.T) {
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		module @castToInt:9;;
+
+		-> $value;;
+		<- $number;;
+
+		try {
+			$number = @castToInt($value);;
+		} catch $err {
+			fail $err;;
+		};;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = application.Interpret([]interface{}{
+		"invalid",
+	}, program)
+
+	var pErr *interpreter_applications.Error
+	if !errors.As(err, &pErr) {
+		t.Errorf("the error was expected to contain an Error, returned: %v", err)
+		return
+	}
+
+	if pErr.Module == nil || *pErr.Module != ModuleCastToInt {
+		t.Errorf("the error was expected to be raised by the module (index: %d)", ModuleCastToInt)
+		return
+	}
+}
+
+func TestTry_exceededLimit_isNotCaught(t *testing.T) {
+	limitsIns, err := limits.NewBuilder().Create().WithInstructions(2).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		WithLimits(limitsIns).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		module @castToBool:11;;
+
+		-> $value;;
+		<- $caught;;
+
+		try {
+			@castToBool($value);;
+			@castToBool($value);;
+			@castToBool($value);;
+		} catch $err {
+			$caught = true;;
+		};;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = application.Interpret([]interface{}{
+		"true",
+	}, program)
+
+	if !errors.Is(err, limits.ErrInstructionsExceeded) {
+		t.Errorf("the error was expected to be ErrInstructionsExceeded, returned: %v", err)
+		return
+	}
+}
+
+func TestTry_exceededCallDepth_isNotCaught(t *testing.T) {
+	limitsIns, err := limits.NewBuilder().Create().WithCallDepth(2).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	application, err := NewApplicationBuilder().Create().
+		WithBasePath(os.TempDir()).
+		WithChunkSize(1024).
+		WithLimits(limitsIns).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		-> $flag;;
+		<- $caught;;
+
+		$fn = {
+			-> $recurse;;
+			<- $result;;
+
+			attach $recurse:0 $fn;;
+			$result = execute $fn;;
+		};;
+
+		try {
+			attach $flag:0 $fn;;
+			$value = execute $fn;;
+		} catch $err {
+			$caught = true;;
+		};;
+	`
+
+	treeIns, err := application.Lex([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, _, err := application.Parse(treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = application.Interpret([]interface{}{
+		true,
+	}, program)
+
+	if !errors.Is(err, interpreter_applications.ErrCallDepthExceeded) {
+		t.Errorf("the error was expected to be ErrCallDepthExceeded, returned: %v", err)
+		return
+	}
+}
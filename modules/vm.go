@@ -1,37 +1,46 @@
 package modules
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/steve-care-software/ast/domain/trees"
 	"github.com/steve-care-software/interpreter/domain/programs"
 	"github.com/steve-care-software/interpreter/domain/programs/modules"
+	"github.com/steve-care-software/rodan/capabilities"
 	"github.com/steve-care-software/vm/applications"
 )
 
 type vm struct {
 	vmApplication applications.Application
+	environment   *environment
+	capabilities  capabilities.Capabilities
 }
 
 func createVM(
 	vmApplication applications.Application,
+	environment *environment,
+	capabilities capabilities.Capabilities,
 ) *vm {
 	out := vm{
 		vmApplication: vmApplication,
+		environment:   environment,
+		capabilities:  capabilities,
 	}
 
 	return &out
 }
 
 // Execute executes the application
-func (app *vm) Execute() map[uint]modules.ExecuteFn {
+func (app *vm) Execute() map[uint]modules.ExecuteContextFn {
 	lex := app.lex()
 	parse := app.parse()
 	interpret := app.interpret()
 	lexParseThenInterpret := app.lexParseThenInterpret()
 	lexParseThenInterpretSingle := app.lexParseThenInterpretSingle()
-	return map[uint]modules.ExecuteFn{
+	return map[uint]modules.ExecuteContextFn{
 		ModuleVMLex:                   lex,
 		ModuleVMParse:                 parse,
 		ModuleVMInterpret:             interpret,
@@ -40,8 +49,8 @@ func (app *vm) Execute() map[uint]modules.ExecuteFn {
 	}
 }
 
-func (app *vm) lex() modules.ExecuteFn {
-	return func(input map[uint]interface{}) (interface{}, error) {
+func (app *vm) lex() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
 		if script, ok := input[0].([]byte); ok {
 			return app.vmApplication.Lex(script)
 		}
@@ -51,10 +60,15 @@ func (app *vm) lex() modules.ExecuteFn {
 	}
 }
 
-func (app *vm) parse() modules.ExecuteFn {
-	return func(input map[uint]interface{}) (interface{}, error) {
+func (app *vm) parse() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
 		if treeIns, ok := input[0].(trees.Tree); ok {
-			programIns, remaining, err := app.vmApplication.Parse(treeIns)
+			capabilitiesIns, err := app.environment.restrict(app.capabilities, treeIns.Bytes(true))
+			if err != nil {
+				return nil, err
+			}
+
+			programIns, remaining, err := app.environment.application(capabilitiesIns).ParseContext(ctx, treeIns)
 			if err != nil {
 				return nil, err
 			}
@@ -71,15 +85,20 @@ func (app *vm) parse() modules.ExecuteFn {
 	}
 }
 
-func (app *vm) interpret() modules.ExecuteFn {
-	return func(input map[uint]interface{}) (interface{}, error) {
+func (app *vm) interpret() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
 		if programIns, ok := input[0].(programs.Program); ok {
 			params := []interface{}{}
 			if inputList, ok := input[1].([]interface{}); ok {
 				params = inputList
 			}
 
-			return app.vmApplication.Interpret(params, programIns)
+			sandboxCtx, err := app.sandbox(ctx, input, 2)
+			if err != nil {
+				return nil, err
+			}
+
+			return app.interpretProgram(sandboxCtx, params, programIns)
 		}
 
 		str := fmt.Sprintf("the input at index (%d) was expected to contain a Program", 0)
@@ -87,15 +106,15 @@ func (app *vm) interpret() modules.ExecuteFn {
 	}
 }
 
-func (app *vm) lexParseThenInterpret() modules.ExecuteFn {
-	return func(input map[uint]interface{}) (interface{}, error) {
-		return app.lexParseThenInterpreterInput(input)
+func (app *vm) lexParseThenInterpret() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
+		return app.lexParseThenInterpreterInput(ctx, input)
 	}
 }
 
-func (app *vm) lexParseThenInterpretSingle() modules.ExecuteFn {
-	return func(input map[uint]interface{}) (interface{}, error) {
-		list, err := app.lexParseThenInterpreterInput(input)
+func (app *vm) lexParseThenInterpretSingle() modules.ExecuteContextFn {
+	return func(ctx context.Context, input map[uint]interface{}) (interface{}, error) {
+		list, err := app.lexParseThenInterpreterInput(ctx, input)
 		if err != nil {
 			return nil, err
 		}
@@ -108,14 +127,26 @@ func (app *vm) lexParseThenInterpretSingle() modules.ExecuteFn {
 	}
 }
 
-func (app *vm) lexParseThenInterpreterInput(input map[uint]interface{}) ([]interface{}, error) {
+func (app *vm) lexParseThenInterpreterInput(ctx context.Context, input map[uint]interface{}) ([]interface{}, error) {
 	if script, ok := input[0].([]byte); ok {
-		treeIns, err := app.vmApplication.Lex(script)
+		capabilitiesIns, err := app.restrict(script, input, 4)
+		if err != nil {
+			return nil, err
+		}
+
+		vmApplication := app.environment.application(capabilitiesIns)
+		treeIns, err := vmApplication.Lex(script)
+		if err != nil {
+			return nil, err
+		}
+
+		// the scripts it imports are read within its sandbox:
+		sandboxCtx, err := app.sandbox(ctx, input, 2)
 		if err != nil {
 			return nil, err
 		}
 
-		programIns, remaining, err := app.vmApplication.Parse(treeIns)
+		programIns, remaining, err := vmApplication.ParseContext(sandboxCtx, treeIns)
 		if err != nil {
 			return nil, err
 		}
@@ -129,9 +160,73 @@ func (app *vm) lexParseThenInterpreterInput(input map[uint]interface{}) ([]inter
 			params = inputList
 		}
 
-		return app.vmApplication.Interpret(params, programIns)
+		return app.interpretProgram(sandboxCtx, params, programIns)
 	}
 
 	str := fmt.Sprintf("the input at index (%d) was expected to contain []byte", 0)
 	return nil, errors.New(str)
 }
+
+func (app *vm) restrict(script []byte, input map[uint]interface{}, manifestIndex uint) (capabilities.Capabilities, error) {
+	capabilitiesIns, err := app.environment.restrict(app.capabilities, script)
+	if err != nil {
+		return nil, err
+	}
+
+	switch value := input[manifestIndex].(type) {
+	case nil:
+		return capabilitiesIns, nil
+	case capabilities.Capabilities:
+		return intersect(capabilitiesIns, value), nil
+	case []byte:
+		requested, err := app.environment.adapter.ToCapabilities(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return intersect(capabilitiesIns, requested), nil
+	}
+
+	str := fmt.Sprintf("the input at index (%d) was expected to contain a capabilities manifest", manifestIndex)
+	return nil, errors.New(str)
+}
+
+func (app *vm) sandbox(ctx context.Context, input map[uint]interface{}, subDirectoryIndex uint) (context.Context, error) {
+	subDirectory := ""
+	if value, ok := input[subDirectoryIndex]; ok && value != nil {
+		casted, ok := value.([]byte)
+		if !ok {
+			str := fmt.Sprintf("the input at index (%d) was expected to contain []byte", subDirectoryIndex)
+			return nil, errors.New(str)
+		}
+
+		subDirectory = strings.TrimSpace(string(casted))
+	}
+
+	isReadOnly := false
+	if value, ok := input[subDirectoryIndex+1]; ok && value != nil {
+		casted, ok := value.(bool)
+		if !ok {
+			str := fmt.Sprintf("the input at index (%d) was expected to contain a bool", subDirectoryIndex+1)
+			return nil, errors.New(str)
+		}
+
+		isReadOnly = casted
+	}
+
+	if subDirectory == "" && !isReadOnly {
+		return ctx, nil
+	}
+
+	return withSandbox(ctx, subDirectory, isReadOnly)
+}
+
+func (app *vm) interpretProgram(ctx context.Context, params []interface{}, programIns programs.Program) ([]interface{}, error) {
+	err := meterFromContext(ctx, app.environment.meter).enter()
+	if err != nil {
+		return nil, err
+	}
+
+	defer meterFromContext(ctx, app.environment.meter).leave()
+	return app.vmApplication.InterpretContext(ctx, params, programIns)
+}
@@ -0,0 +1,8 @@
+package queries
+
+import "github.com/steve-care-software/interpreter/domain/instructions"
+
+type branch struct {
+	instructions instructions.Instructions
+	isElse       bool
+}
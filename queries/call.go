@@ -0,0 +1,12 @@
+package queries
+
+type call struct {
+	module    []byte
+	arguments []*callArgument
+}
+
+type callArgument struct {
+	variable  []byte
+	call      *call
+	isSkipped bool
+}
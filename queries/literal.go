@@ -0,0 +1,5 @@
+package queries
+
+type literal struct {
+	value interface{}
+}
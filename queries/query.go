@@ -1,9 +1,12 @@
 package queries
 
 import (
+	"bytes"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
+	"sync/atomic"
 
 	"github.com/steve-care-software/ast/domain/trees"
 	"github.com/steve-care-software/interpreter/domain/instructions"
@@ -15,6 +18,7 @@ import (
 )
 
 type query struct {
+	hiddenIndex                          uint64
 	builder                              queries.Builder
 	queryFnBuilder                       queries.QueryFnBuilder
 	tokenBuilder                         queries.TokenBuilder
@@ -32,6 +36,10 @@ type query struct {
 	instructionAssignmentBuilder         instructions.AssignmentBuilder
 	instructionValueBuilder              instructions.ValueBuilder
 	instructionModuleBuilder             modules.Builder
+	instructionConditionBuilder          instructions.ConditionBuilder
+	instructionLoopBuilder               instructions.LoopBuilder
+	instructionImportBuilder             instructions.ImportBuilder
+	instructionTryBuilder                instructions.TryBuilder
 }
 
 func createQuery(
@@ -52,6 +60,10 @@ func createQuery(
 	instructionAssignmentBuilder instructions.AssignmentBuilder,
 	instructionValueBuilder instructions.ValueBuilder,
 	instructionModuleBuilder modules.Builder,
+	instructionConditionBuilder instructions.ConditionBuilder,
+	instructionLoopBuilder instructions.LoopBuilder,
+	instructionImportBuilder instructions.ImportBuilder,
+	instructionTryBuilder instructions.TryBuilder,
 ) *query {
 	out := query{
 		builder:                              builder,
@@ -71,6 +83,10 @@ func createQuery(
 		instructionAssignmentBuilder:         instructionAssignmentBuilder,
 		instructionValueBuilder:              instructionValueBuilder,
 		instructionModuleBuilder:             instructionModuleBuilder,
+		instructionConditionBuilder:          instructionConditionBuilder,
+		instructionLoopBuilder:               instructionLoopBuilder,
+		instructionImportBuilder:             instructionImportBuilder,
+		instructionTryBuilder:                instructionTryBuilder,
 	}
 
 	return &out
@@ -92,8 +108,15 @@ func (app *query) instructions() queries.Query {
 			app.applicationDeclaration(),
 			app.parameter(),
 			app.execute("instruction"),
+			app.callInstruction(),
+			app.literalAssignment(),
 			app.assignment(),
 			app.attachment(),
+			app.condition(),
+			app.loopInstruction(),
+			app.importInstruction(),
+			app.tryInstruction(),
+			app.failInstruction(),
 		}),
 		func(instances []interface{}) (interface{}, bool, error) {
 			list := []instructions.Instruction{}
@@ -103,19 +126,974 @@ func (app *query) instructions() queries.Query {
 					continue
 				}
 
+				if casted, ok := oneIns.([]instructions.Instruction); ok {
+					list = append(list, casted...)
+					continue
+				}
+
 				str := fmt.Sprintf("the instruction (index: %d) could not be properly casted", idx)
 				return nil, false, errors.New(str)
 			}
 
-			ins, err := app.instructionsBuilder.Create().
-				WithList(list).
-				Now()
+			ins, err := app.instructionsBuilder.Create().
+				WithList(list).
+				Now()
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			return ins, true, nil
+		},
+	)
+}
+
+func (app *query) condition() queries.Query {
+	return app.queryWithMultiFn(
+		app.tokenWithContentIndex(
+			"instruction",
+			app.element("condition", 0),
+			0,
+		),
+		app.insideWithQueries([]queries.Query{
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"condition",
+					app.element("variableReference", 0),
+					0,
+				),
+				app.insideWithQuery(app.variableReference()),
+				func(instance interface{}) (interface{}, bool, error) {
+					return instance.([]byte), true, nil
+				},
+			),
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"condition",
+					app.element("conditionBranch", 0),
+					0,
+				),
+				app.insideWithQuery(app.conditionBranch()),
+				func(instance interface{}) (interface{}, bool, error) {
+					return &branch{
+						instructions: instance.(instructions.Instructions),
+					}, true, nil
+				},
+			),
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"condition",
+					app.element("elseBranch", 0),
+					0,
+				),
+				app.insideWithQuery(
+					app.queryWithSingleFn(
+						app.tokenWithContentIndex(
+							"elseBranch",
+							app.element("conditionBranch", 0),
+							0,
+						),
+						app.insideWithQuery(app.conditionBranch()),
+						func(instance interface{}) (interface{}, bool, error) {
+							return instance.(instructions.Instructions), true, nil
+						},
+					),
+				),
+				func(instance interface{}) (interface{}, bool, error) {
+					return &branch{
+						instructions: instance.(instructions.Instructions),
+						isElse:       true,
+					}, true, nil
+				},
+			),
+		}),
+		func(instances []interface{}) (interface{}, bool, error) {
+			if len(instances) < 1 {
+				return nil, false, errors.New("the condition was expected to contain a variable")
+			}
+
+			builder := app.instructionConditionBuilder.Create().
+				WithVariable(instances[0].([]byte))
+
+			for idx, oneInstance := range instances[1:] {
+				casted, ok := oneInstance.(*branch)
+				if !ok {
+					str := fmt.Sprintf("the condition's branch (index: %d) could not be casted properly", idx)
+					return nil, false, errors.New(str)
+				}
+
+				if casted.isElse {
+					builder.WithElse(casted.instructions)
+					continue
+				}
+
+				builder.WithThen(casted.instructions)
+			}
+
+			condition, err := builder.Now()
+			if err != nil {
+				return nil, false, err
+			}
+
+			ins, err := app.instructionBuilder.Create().
+				WithCondition(condition).
+				Now()
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			return ins, true, nil
+		},
+	)
+}
+
+func (app *query) importInstruction() queries.Query {
+	return app.queryWithMultiFn(
+		app.tokenWithContentIndex(
+			"instruction",
+			app.element("import", 0),
+			0,
+		),
+		app.insideWithQueries([]queries.Query{
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"import",
+					app.element("importPath", 0),
+					0,
+				),
+				app.insideWithQuery(
+					app.queryWithSingleFn(
+						app.tokenWithContentIndex(
+							"importPath",
+							app.element("everythingExceptImportPathDelimiter", 0),
+							0,
+						),
+						app.fetchAllContentInside(),
+						func(instance interface{}) (interface{}, bool, error) {
+							return instance.([]byte), true, nil
+						},
+					),
+				),
+				func(instance interface{}) (interface{}, bool, error) {
+					return instance.([]byte), true, nil
+				},
+			),
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"import",
+					app.element("variableReference", 0),
+					0,
+				),
+				app.insideWithQuery(app.variableReference()),
+				func(instance interface{}) (interface{}, bool, error) {
+					return instance.([]byte), true, nil
+				},
+			),
+		}),
+		func(instances []interface{}) (interface{}, bool, error) {
+			if len(instances) != 2 {
+				str := fmt.Sprintf("%d elements were expected, %d returned", 2, len(instances))
+				return nil, false, errors.New(str)
+			}
+
+			importIns, err := app.instructionImportBuilder.Create().
+				WithPath(instances[0].([]byte)).
+				WithVariable(instances[1].([]byte)).
+				Now()
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			ins, err := app.instructionBuilder.Create().
+				WithImport(importIns).
+				Now()
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			return ins, true, nil
+		},
+	)
+}
+
+func (app *query) tryInstruction() queries.Query {
+	return app.queryWithMultiFn(
+		app.tokenWithContentIndex(
+			"instruction",
+			app.element("try", 0),
+			0,
+		),
+		app.insideWithQueries([]queries.Query{
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"try",
+					app.element("tryBody", 0),
+					0,
+				),
+				app.insideWithQuery(app.instructionsBlock("tryBody")),
+				func(instance interface{}) (interface{}, bool, error) {
+					return instance.(instructions.Instructions), true, nil
+				},
+			),
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"try",
+					app.element("variableReference", 0),
+					0,
+				),
+				app.insideWithQuery(app.variableReference()),
+				func(instance interface{}) (interface{}, bool, error) {
+					return instance.([]byte), true, nil
+				},
+			),
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"try",
+					app.element("catchBody", 0),
+					0,
+				),
+				app.insideWithQuery(app.instructionsBlock("catchBody")),
+				func(instance interface{}) (interface{}, bool, error) {
+					return instance.(instructions.Instructions), true, nil
+				},
+			),
+		}),
+		func(instances []interface{}) (interface{}, bool, error) {
+			// the instructions found before the variable belong to the try, the ones found after it to the catch:
+			builder := app.instructionTryBuilder.Create()
+			isCatch := false
+			for idx, oneInstance := range instances {
+				if casted, ok := oneInstance.([]byte); ok {
+					builder.WithVariable(casted)
+					isCatch = true
+					continue
+				}
+
+				casted, ok := oneInstance.(instructions.Instructions)
+				if !ok {
+					str := fmt.Sprintf("the try's element (index: %d) could not be casted properly", idx)
+					return nil, false, errors.New(str)
+				}
+
+				if isCatch {
+					builder.WithCatch(casted)
+					continue
+				}
+
+				builder.WithInstructions(casted)
+			}
+
+			tryIns, err := builder.Now()
+			if err != nil {
+				return nil, false, err
+			}
+
+			ins, err := app.instructionBuilder.Create().
+				WithTry(tryIns).
+				Now()
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			return ins, true, nil
+		},
+	)
+}
+
+func (app *query) failInstruction() queries.Query {
+	return app.queryWithSingleFn(
+		app.tokenWithContentIndex(
+			"instruction",
+			app.element("fail", 0),
+			0,
+		),
+		app.insideWithQuery(
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"fail",
+					app.element("failValue", 0),
+					0,
+				),
+				app.insideWithQueries([]queries.Query{
+					app.queryWithSingleFn(
+						app.tokenWithContentIndex(
+							"failValue",
+							app.element("variableReference", 0),
+							0,
+						),
+						app.insideWithQuery(app.variableReference()),
+						func(instance interface{}) (interface{}, bool, error) {
+							return instance.([]byte), true, nil
+						},
+					),
+					app.queryWithSingleFn(
+						app.tokenWithContentIndex(
+							"failValue",
+							app.element("literal", 0),
+							0,
+						),
+						app.insideWithQuery(app.literal()),
+						func(instance interface{}) (interface{}, bool, error) {
+							return instance, true, nil
+						},
+					),
+				}),
+				func(instance interface{}) (interface{}, bool, error) {
+					return instance, true, nil
+				},
+			),
+		),
+		func(instance interface{}) (interface{}, bool, error) {
+			if casted, ok := instance.([]byte); ok {
+				ins, err := app.instructionBuilder.Create().
+					WithFail(casted).
+					Now()
+
+				if err != nil {
+					return nil, false, err
+				}
+
+				return ins, true, nil
+			}
+
+			casted, ok := instance.(*literal)
+			if !ok {
+				return nil, false, errors.New("the fail was expected to contain a variable or a literal")
+			}
+
+			// a literal is assigned to a hidden variable, then failed with:
+			variable := app.hiddenName([]byte("fail"))
+			value, err := app.instructionValueBuilder.Create().
+				WithLiteral(casted.value).
+				Now()
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			assignment, err := app.instructionAssignmentBuilder.Create().
+				WithVariable(variable).
+				WithValue(value).
+				Now()
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			assignmentIns, err := app.instructionBuilder.Create().
+				WithAssignment(assignment).
+				Now()
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			failIns, err := app.instructionBuilder.Create().
+				WithFail(variable).
+				Now()
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			return []instructions.Instruction{
+				assignmentIns,
+				failIns,
+			}, true, nil
+		},
+	)
+}
+
+func (app *query) loopInstruction() queries.Query {
+	return app.queryWithSingleFn(
+		app.tokenWithContentIndex(
+			"instruction",
+			app.element("loop", 0),
+			0,
+		),
+		app.loop(),
+		func(instance interface{}) (interface{}, bool, error) {
+			if casted, ok := instance.(instructions.Loop); ok {
+				ins, err := app.instructionBuilder.Create().
+					WithLoop(casted).
+					Now()
+
+				if err != nil {
+					return nil, false, err
+				}
+
+				return ins, true, nil
+			}
+
+			return nil, false, errors.New("the loop could not be casted properly")
+		},
+	)
+}
+
+func (app *query) loop() queries.Inside {
+	return app.insideWithQueries([]queries.Query{
+		app.forLoop(),
+		app.whileLoop(),
+	})
+}
+
+func (app *query) forLoop() queries.Query {
+	return app.queryWithMultiFn(
+		app.tokenWithContentIndex(
+			"loop",
+			app.element("forLoop", 0),
+			0,
+		),
+		app.insideWithQueries([]queries.Query{
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"forLoop",
+					app.element("variableReference", 0),
+					0,
+				),
+				app.insideWithQuery(app.variableReference()),
+				func(instance interface{}) (interface{}, bool, error) {
+					return instance.([]byte), true, nil
+				},
+			),
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"forLoop",
+					app.element("variableReference", 1),
+					0,
+				),
+				app.insideWithQuery(app.variableReference()),
+				func(instance interface{}) (interface{}, bool, error) {
+					return instance.([]byte), true, nil
+				},
+			),
+			app.loopBody("forLoop"),
+		}),
+		func(instances []interface{}) (interface{}, bool, error) {
+			if len(instances) < 2 {
+				str := fmt.Sprintf("at least %d elements were expected, %d returned", 2, len(instances))
+				return nil, false, errors.New(str)
+			}
+
+			builder := app.instructionLoopBuilder.Create().
+				WithItem(instances[0].([]byte)).
+				WithVariable(instances[1].([]byte))
+
+			if len(instances) > 2 {
+				builder.WithInstructions(instances[2].(instructions.Instructions))
+			}
+
+			ins, err := builder.Now()
+			if err != nil {
+				return nil, false, err
+			}
+
+			return ins, true, nil
+		},
+	)
+}
+
+func (app *query) whileLoop() queries.Query {
+	return app.queryWithMultiFn(
+		app.tokenWithContentIndex(
+			"loop",
+			app.element("whileLoop", 0),
+			0,
+		),
+		app.insideWithQueries([]queries.Query{
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"whileLoop",
+					app.element("variableReference", 0),
+					0,
+				),
+				app.insideWithQuery(app.variableReference()),
+				func(instance interface{}) (interface{}, bool, error) {
+					return instance.([]byte), true, nil
+				},
+			),
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"whileLoop",
+					app.element("loopMaximum", 0),
+					0,
+				),
+				app.insideWithQuery(
+					app.queryWithSingleFn(
+						app.tokenWithContentIndex(
+							"loopMaximum",
+							app.element("number", 0),
+							0,
+						),
+						app.fetchAllContentInside(),
+						func(instance interface{}) (interface{}, bool, error) {
+							return instance.([]byte), true, nil
+						},
+					),
+				),
+				func(instance interface{}) (interface{}, bool, error) {
+					return instance, true, nil
+				},
+			),
+			app.loopBody("whileLoop"),
+		}),
+		func(instances []interface{}) (interface{}, bool, error) {
+			if len(instances) < 2 {
+				str := fmt.Sprintf("at least %d elements were expected, %d returned", 2, len(instances))
+				return nil, false, errors.New(str)
+			}
+
+			maximum, err := strconv.Atoi(string(instances[1].([]byte)))
+			if err != nil {
+				return nil, false, err
+			}
+
+			builder := app.instructionLoopBuilder.Create().
+				WithVariable(instances[0].([]byte)).
+				WithMaximum(uint(maximum))
+
+			if len(instances) > 2 {
+				builder.WithInstructions(instances[2].(instructions.Instructions))
+			}
+
+			ins, err := builder.Now()
+			if err != nil {
+				return nil, false, err
+			}
+
+			return ins, true, nil
+		},
+	)
+}
+
+func (app *query) loopBody(tokenName string) queries.Query {
+	return app.queryWithSingleFn(
+		app.tokenWithContentIndex(
+			tokenName,
+			app.element("loopBody", 0),
+			0,
+		),
+		app.insideWithQuery(app.instructionsBlock("loopBody")),
+		func(instance interface{}) (interface{}, bool, error) {
+			return instance.(instructions.Instructions), true, nil
+		},
+	)
+}
+
+func (app *query) loopAssignment() queries.Query {
+	return app.queryWithMultiFn(
+		app.tokenWithContentIndex(
+			"assignment",
+			app.element("loopAssignment", 0),
+			0,
+		),
+		app.insideWithQueries([]queries.Query{
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"loopAssignment",
+					app.element("variableReference", 0),
+					0,
+				),
+				app.insideWithQuery(app.variableReference()),
+				func(instance interface{}) (interface{}, bool, error) {
+					return instance.([]byte), true, nil
+				},
+			),
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"loopAssignment",
+					app.element("loop", 0),
+					0,
+				),
+				app.loop(),
+				func(instance interface{}) (interface{}, bool, error) {
+					return instance, true, nil
+				},
+			),
+		}),
+		func(instances []interface{}) (interface{}, bool, error) {
+			if len(instances) != 2 {
+				str := fmt.Sprintf("%d elements were expected, %d returned", 2, len(instances))
+				return nil, false, errors.New(str)
+			}
+
+			casted, ok := instances[1].(instructions.Loop)
+			if !ok {
+				return nil, false, errors.New("the loop could not be casted properly")
+			}
+
+			value, err := app.instructionValueBuilder.Create().
+				WithLoop(casted).
+				Now()
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			ins, err := app.instructionAssignmentBuilder.Create().
+				WithVariable(instances[0].([]byte)).
+				WithValue(value).
+				Now()
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			return ins, true, nil
+		},
+	)
+}
+
+func (app *query) callInstruction() queries.Query {
+	return app.queryWithSingleFn(
+		app.tokenWithContentIndex(
+			"instruction",
+			app.element("call", 0),
+			0,
+		),
+		app.insideWithQuery(app.call()),
+		func(instance interface{}) (interface{}, bool, error) {
+			list, err := app.lowerCall(instance.(*call), nil)
+			if err != nil {
+				return nil, false, err
+			}
+
+			return list, true, nil
+		},
+	)
+}
+
+func (app *query) callAssignment() queries.Query {
+	return app.queryWithMultiFn(
+		app.tokenWithContentIndex(
+			"assignment",
+			app.element("callAssignment", 0),
+			0,
+		),
+		app.insideWithQueries([]queries.Query{
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"callAssignment",
+					app.element("variableReference", 0),
+					0,
+				),
+				app.insideWithQuery(app.variableReference()),
+				func(instance interface{}) (interface{}, bool, error) {
+					return instance.([]byte), true, nil
+				},
+			),
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"callAssignment",
+					app.element("call", 0),
+					0,
+				),
+				app.insideWithQuery(app.call()),
+				func(instance interface{}) (interface{}, bool, error) {
+					return instance, true, nil
+				},
+			),
+		}),
+		func(instances []interface{}) (interface{}, bool, error) {
+			if len(instances) != 2 {
+				str := fmt.Sprintf("%d elements were expected, %d returned", 2, len(instances))
+				return nil, false, errors.New(str)
+			}
+
+			list, err := app.lowerCall(instances[1].(*call), instances[0].([]byte))
+			if err != nil {
+				return nil, false, err
+			}
+
+			return list, true, nil
+		},
+	)
+}
+
+func (app *query) call() queries.Query {
+	return app.queryWithMultiFn(
+		app.tokenWithContentIndex(
+			"call",
+			app.element("callExpression", 0),
+			0,
+		),
+		app.insideWithQueries([]queries.Query{
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"callExpression",
+					app.element("moduleReference", 0),
+					0,
+				),
+				app.insideWithQuery(app.moduleReference()),
+				func(instance interface{}) (interface{}, bool, error) {
+					return instance.([]byte), true, nil
+				},
+			),
+			app.queryWithMultiFn(
+				app.tokenWithContentIndex(
+					"callExpression",
+					app.element("callArguments", 0),
+					0,
+				),
+				app.insideWithQueries([]queries.Query{
+					app.queryWithSingleFn(
+						app.tokenWithContentIndex(
+							"callArguments",
+							app.element("callArgument", 0),
+							0,
+						),
+						app.callArgument(),
+						func(instance interface{}) (interface{}, bool, error) {
+							return instance, true, nil
+						},
+					),
+					app.queryWithMultiFn(
+						app.token(
+							"callArguments",
+							app.element("callNextArgument", 0),
+						),
+						app.insideWithQuery(
+							app.queryWithSingleFn(
+								app.tokenWithContentIndex(
+									"callNextArgument",
+									app.element("callArgument", 0),
+									0,
+								),
+								app.callArgument(),
+								func(instance interface{}) (interface{}, bool, error) {
+									return instance, true, nil
+								},
+							),
+						),
+						func(instances []interface{}) (interface{}, bool, error) {
+							return instances, true, nil
+						},
+					),
+				}),
+				func(instances []interface{}) (interface{}, bool, error) {
+					arguments := []*callArgument{}
+					for _, oneInstance := range instances {
+						if casted, ok := oneInstance.([]interface{}); ok {
+							for _, oneNext := range casted {
+								arguments = append(arguments, oneNext.(*callArgument))
+							}
+
+							continue
+						}
+
+						arguments = append(arguments, oneInstance.(*callArgument))
+					}
+
+					return arguments, true, nil
+				},
+			),
+		}),
+		func(instances []interface{}) (interface{}, bool, error) {
+			if len(instances) < 1 {
+				return nil, false, errors.New("the call was expected to contain a module")
+			}
+
+			out := call{
+				module:    instances[0].([]byte),
+				arguments: []*callArgument{},
+			}
+
+			if len(instances) > 1 {
+				out.arguments = instances[1].([]*callArgument)
+			}
+
+			return &out, true, nil
+		},
+	)
+}
+
+func (app *query) callArgument() queries.Inside {
+	return app.insideWithQueries([]queries.Query{
+		app.queryWithSingleFn(
+			app.tokenWithContentIndex(
+				"callArgument",
+				app.element("variableReference", 0),
+				0,
+			),
+			app.insideWithQuery(app.variableReference()),
+			func(instance interface{}) (interface{}, bool, error) {
+				return &callArgument{
+					variable: instance.([]byte),
+				}, true, nil
+			},
+		),
+		app.queryWithSingleFn(
+			app.tokenWithContentIndex(
+				"callArgument",
+				app.element("skippedCallArgument", 0),
+				0,
+			),
+			app.fetchAllContentInside(),
+			func(instance interface{}) (interface{}, bool, error) {
+				return &callArgument{
+					isSkipped: true,
+				}, true, nil
+			},
+		),
+		app.queryWithSingleFn(
+			app.tokenWithContentIndex(
+				"callArgument",
+				app.element("call", 0),
+				0,
+			),
+			app.insideWithRecursive("call"),
+			func(instance interface{}) (interface{}, bool, error) {
+				if casted, ok := instance.(*call); ok {
+					return &callArgument{
+						call: casted,
+					}, true, nil
+				}
+
+				return nil, false, errors.New("the instance was expected to contain a call")
+			},
+		),
+	})
+}
+
+// lowerCall lowers a call into the declaration of a fresh application, its attachments and its execution, assigned to the variable if any, so that no attachment outlives the call
+func (app *query) lowerCall(callIns *call, variable []byte) ([]instructions.Instruction, error) {
+	appName := app.hiddenName(callIns.module)
+	appIns, err := app.instructionApplicationBuilder.Create().
+		WithName(appName).
+		WithModule(callIns.module).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	declaration, err := app.instructionBuilder.Create().
+		WithApplication(appIns).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := []instructions.Instruction{
+		declaration,
+	}
+
+	attachmentsList := []instructions.Instruction{}
+	for idx, oneArgument := range callIns.arguments {
+		if oneArgument.isSkipped {
+			continue
+		}
 
+		current := oneArgument.variable
+		if oneArgument.call != nil {
+			current = app.hiddenName(oneArgument.call.module)
+			nested, err := app.lowerCall(oneArgument.call, current)
 			if err != nil {
-				return nil, false, err
+				return nil, err
 			}
 
-			return ins, true, nil
+			output = append(output, nested...)
+		}
+
+		attachmentVariable, err := app.instructionAttachmentVariableBuilder.Create().
+			WithCurrent(current).
+			WithTarget(uint(idx)).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		attachment, err := app.instructionAttachmentBuilder.Create().
+			WithVariable(attachmentVariable).
+			WithApplication(appName).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		ins, err := app.instructionBuilder.Create().
+			WithAttachment(attachment).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		attachmentsList = append(attachmentsList, ins)
+	}
+
+	output = append(output, attachmentsList...)
+	if variable == nil {
+		execution, err := app.instructionBuilder.Create().
+			WithExecution(appName).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return append(output, execution), nil
+	}
+
+	value, err := app.instructionValueBuilder.Create().
+		WithExecution(appName).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	assignment, err := app.instructionAssignmentBuilder.Create().
+		WithVariable(variable).
+		WithValue(value).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	ins, err := app.instructionBuilder.Create().
+		WithAssignment(assignment).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append(output, ins), nil
+}
+
+// hiddenName returns a unique name, prefixed by the provided prefix, for a hidden application or variable, that the grammar does not allow in a script
+func (app *query) hiddenName(prefix []byte) []byte {
+	index := atomic.AddUint64(&app.hiddenIndex, 1)
+	return []byte(fmt.Sprintf("%s#%d", prefix, index))
+}
+
+func (app *query) conditionBranch() queries.Query {
+	return app.instructionsBlock("conditionBranch")
+}
+
+func (app *query) instructionsBlock(tokenName string) queries.Query {
+	return app.queryWithSingleFn(
+		app.tokenWithContentIndex(
+			tokenName,
+			app.element("instructions", 0),
+			0,
+		),
+		app.insideWithRecursive("instructions"),
+		func(instance interface{}) (interface{}, bool, error) {
+			if casted, ok := instance.(instructions.Instructions); ok {
+				return casted, true, nil
+			}
+
+			return nil, false, errors.New("the instance was expected to contain an Instructions instance")
 		},
 	)
 }
@@ -226,9 +1204,15 @@ func (app *query) assignment() queries.Query {
 			app.variableAssignment(),
 			app.executionAssignment(),
 			app.instructionsAssignment(),
+			app.loopAssignment(),
+			app.callAssignment(),
 			app.constantAssignment(),
 		}),
 		func(instance interface{}) (interface{}, bool, error) {
+			if casted, ok := instance.([]instructions.Instruction); ok {
+				return casted, true, nil
+			}
+
 			if casted, ok := instance.(instructions.Assignment); ok {
 				ins, err := app.instructionBuilder.Create().
 					WithAssignment(casted).
@@ -246,6 +1230,206 @@ func (app *query) assignment() queries.Query {
 	)
 }
 
+func (app *query) literalAssignment() queries.Query {
+	return app.queryWithMultiFn(
+		app.tokenWithContentIndex(
+			"instruction",
+			app.element("literalAssignment", 0),
+			0,
+		),
+		app.insideWithQueries([]queries.Query{
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"literalAssignment",
+					app.element("variableReference", 0),
+					0,
+				),
+				app.insideWithQuery(app.variableReference()),
+				func(instance interface{}) (interface{}, bool, error) {
+					return instance.([]byte), true, nil
+				},
+			),
+			app.queryWithSingleFn(
+				app.tokenWithContentIndex(
+					"literalAssignment",
+					app.element("literal", 0),
+					0,
+				),
+				app.insideWithQuery(app.literal()),
+				func(instance interface{}) (interface{}, bool, error) {
+					return instance, true, nil
+				},
+			),
+		}),
+		func(instances []interface{}) (interface{}, bool, error) {
+			if len(instances) != 2 {
+				str := fmt.Sprintf("%d elements were expected, %d returned", 2, len(instances))
+				return nil, false, errors.New(str)
+			}
+
+			value, err := app.instructionValueBuilder.Create().
+				WithLiteral(instances[1].(*literal).value).
+				Now()
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			assignment, err := app.instructionAssignmentBuilder.Create().
+				WithVariable(instances[0].([]byte)).
+				WithValue(value).
+				Now()
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			ins, err := app.instructionBuilder.Create().
+				WithAssignment(assignment).
+				Now()
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			return ins, true, nil
+		},
+	)
+}
+
+func (app *query) literal() queries.Query {
+	return app.queryWithSingleFn(
+		app.tokenWithContentIndex(
+			"literal",
+			app.element("literalValue", 0),
+			0,
+		),
+		app.insideWithQueries([]queries.Query{
+			app.literalValue("stringLiteral", func(data []byte) (interface{}, error) {
+				return decodeStringLiteral(data[1 : len(data)-1])
+			}),
+			app.literalValue("bytesLiteral", func(data []byte) (interface{}, error) {
+				return hex.DecodeString(string(data[2:]))
+			}),
+			app.literalValue("numberLiteral", decodeNumberLiteral),
+			app.literalValue("trueLiteral", func(data []byte) (interface{}, error) {
+				return true, nil
+			}),
+			app.literalValue("falseLiteral", func(data []byte) (interface{}, error) {
+				return false, nil
+			}),
+			app.literalValue("emptyListLiteral", func(data []byte) (interface{}, error) {
+				return []interface{}{}, nil
+			}),
+			app.listLiteral(),
+		}),
+		func(instance interface{}) (interface{}, bool, error) {
+			if casted, ok := instance.(*literal); ok {
+				return casted, true, nil
+			}
+
+			return nil, false, errors.New("the instance was expected to contain a literal")
+		},
+	)
+}
+
+func (app *query) literalValue(tokenName string, decodeFn func(data []byte) (interface{}, error)) queries.Query {
+	return app.queryWithSingleFn(
+		app.tokenWithContentIndex(
+			"literalValue",
+			app.element(tokenName, 0),
+			0,
+		),
+		app.fetchAllContentInside(),
+		func(instance interface{}) (interface{}, bool, error) {
+			value, err := decodeFn(instance.([]byte))
+			if err != nil {
+				str := fmt.Sprintf("the literal (token: %s) could not be decoded: %s", tokenName, err.Error())
+				return nil, false, errors.New(str)
+			}
+
+			return &literal{
+				value: value,
+			}, true, nil
+		},
+	)
+}
+
+func (app *query) listLiteral() queries.Query {
+	return app.queryWithSingleFn(
+		app.tokenWithContentIndex(
+			"literalValue",
+			app.element("listLiteral", 0),
+			0,
+		),
+		app.insideWithQuery(
+			app.queryWithMultiFn(
+				app.tokenWithContentIndex(
+					"listLiteral",
+					app.element("listLiteralElements", 0),
+					0,
+				),
+				app.insideWithQueries([]queries.Query{
+					app.queryWithSingleFn(
+						app.tokenWithContentIndex(
+							"listLiteralElements",
+							app.element("literal", 0),
+							0,
+						),
+						app.insideWithRecursive("literal"),
+						func(instance interface{}) (interface{}, bool, error) {
+							return instance, true, nil
+						},
+					),
+					app.queryWithMultiFn(
+						app.token(
+							"listLiteralElements",
+							app.element("listLiteralNextElement", 0),
+						),
+						app.insideWithQuery(
+							app.queryWithSingleFn(
+								app.tokenWithContentIndex(
+									"listLiteralNextElement",
+									app.element("literal", 0),
+									0,
+								),
+								app.insideWithRecursive("literal"),
+								func(instance interface{}) (interface{}, bool, error) {
+									return instance, true, nil
+								},
+							),
+						),
+						func(instances []interface{}) (interface{}, bool, error) {
+							return instances, true, nil
+						},
+					),
+				}),
+				func(instances []interface{}) (interface{}, bool, error) {
+					list := []interface{}{}
+					for _, oneInstance := range instances {
+						if casted, ok := oneInstance.([]interface{}); ok {
+							for _, oneNext := range casted {
+								list = append(list, oneNext.(*literal).value)
+							}
+
+							continue
+						}
+
+						list = append(list, oneInstance.(*literal).value)
+					}
+
+					return list, true, nil
+				},
+			),
+		),
+		func(instance interface{}) (interface{}, bool, error) {
+			return &literal{
+				value: instance,
+			}, true, nil
+		},
+	)
+}
+
 func (app *query) constantAssignment() queries.Query {
 	return app.queryWithMultiFn(
 		app.tokenWithContentIndex(
@@ -984,3 +2168,38 @@ func (app *query) element(
 
 	return ins
 }
+
+// decodeNumberLiteral decodes a number literal into a float64 if it has a fraction, an int if it is signed or an uint otherwise
+func decodeNumberLiteral(data []byte) (interface{}, error) {
+	str := string(data)
+	if bytes.IndexByte(data, '.') >= 0 {
+		return strconv.ParseFloat(str, 64)
+	}
+
+	if data[0] == '-' || data[0] == '+' {
+		value, err := strconv.ParseInt(str, 10, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		return int(value), nil
+	}
+
+	value, err := strconv.ParseUint(str, 10, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return uint(value), nil
+}
+
+// decodeStringLiteral decodes the escape sequences of a string literal, whose escaped delimiters are already unescaped by the lexer
+func decodeStringLiteral(data []byte) ([]byte, error) {
+	escaped := bytes.ReplaceAll(data, []byte(`"`), []byte(`\"`))
+	value, err := strconv.Unquote(fmt.Sprintf(`"%s"`, escaped))
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(value), nil
+}
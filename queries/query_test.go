@@ -1,6 +1,7 @@
 package queries
 
 import (
+	"reflect"
 	"testing"
 
 	grammar_application "github.com/steve-care-software/ast/applications"
@@ -10,7 +11,7 @@ import (
 )
 
 func TestQuery_Success(t *testing.T) {
-	grammarIns := grammars.NewGrammar()
+	grammarIns := grammars.NewInstructionsGrammar()
 	queryIns := NewQuery()
 
 	grammarApp := grammar_application.NewApplication()
@@ -285,3 +286,499 @@ func TestQuery_Success(t *testing.T) {
 	}
 
 }
+
+func TestQuery_withNestedConditions_Success(t *testing.T) {
+	grammarIns := grammars.NewInstructionsGrammar()
+	queryIns := NewQuery()
+
+	grammarApp := grammar_application.NewApplication()
+	queryApp := query_application.NewApplication()
+
+	script := `
+		-> $first;;
+		-> $second;;
+
+		if $first {
+			if $second {
+				$value = both;;
+			} else {
+				$value = first only;;
+			};;
+		} else {
+		};;
+
+		if $second {};;
+	`
+	treeIns, err := grammarApp.Execute(grammarIns, []byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if treeIns.HasRemaining() {
+		t.Errorf("the tree was expected to not contain remaining data")
+		return
+	}
+
+	instructionsIns, isValid, _, err := queryApp.Execute(queryIns, treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !isValid {
+		t.Errorf("the selection was expected to be valid")
+		return
+	}
+
+	list := instructionsIns.(instructions.Instructions).List()
+	if len(list) != 4 {
+		t.Errorf("%d instructions were expected, %d returned", 4, len(list))
+		return
+	}
+
+	if !list[2].IsCondition() {
+		t.Errorf("the instruction (index: %d) was expected to contain a Condition", 2)
+		return
+	}
+
+	outer := list[2].Condition()
+	if string(outer.Variable()) != "first" {
+		t.Errorf("the condition's variable was expected to be '%s', '%s' returned", "first", outer.Variable())
+		return
+	}
+
+	if outer.HasElse() {
+		t.Errorf("the condition was expected to NOT contain an else branch")
+		return
+	}
+
+	if !outer.HasThen() {
+		t.Errorf("the condition was expected to contain a then branch")
+		return
+	}
+
+	thenList := outer.Then().List()
+	if len(thenList) != 1 || !thenList[0].IsCondition() {
+		t.Errorf("the then branch was expected to contain a nested Condition")
+		return
+	}
+
+	inner := thenList[0].Condition()
+	if string(inner.Variable()) != "second" {
+		t.Errorf("the nested condition's variable was expected to be '%s', '%s' returned", "second", inner.Variable())
+		return
+	}
+
+	if !inner.HasThen() || !inner.HasElse() {
+		t.Errorf("the nested condition was expected to contain a then and an else branch")
+		return
+	}
+
+	elseConstant := string(inner.Else().List()[0].Assignment().Value().Constant())
+	if elseConstant != " first only" {
+		t.Errorf("the else constant was expected to be '%s', '%s' returned", " first only", elseConstant)
+		return
+	}
+
+	if !list[3].IsCondition() {
+		t.Errorf("the instruction (index: %d) was expected to contain a Condition", 3)
+		return
+	}
+
+	last := list[3].Condition()
+	if last.HasThen() || last.HasElse() {
+		t.Errorf("the last condition was expected to contain empty branches")
+		return
+	}
+}
+
+func TestQuery_withLoops_Success(t *testing.T) {
+	grammarIns := grammars.NewInstructionsGrammar()
+	queryIns := NewQuery()
+
+	grammarApp := grammar_application.NewApplication()
+	queryApp := query_application.NewApplication()
+
+	script := `
+		-> $items;;
+		-> $isRunning;;
+
+		$copies = for $item in $items {
+			<- $copy;;
+			$copy = $item;;
+		};;
+
+		while $isRunning:10 {};;
+	`
+	treeIns, err := grammarApp.Execute(grammarIns, []byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if treeIns.HasRemaining() {
+		t.Errorf("the tree was expected to not contain remaining data")
+		return
+	}
+
+	instructionsIns, isValid, _, err := queryApp.Execute(queryIns, treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !isValid {
+		t.Errorf("the selection was expected to be valid")
+		return
+	}
+
+	list := instructionsIns.(instructions.Instructions).List()
+	if len(list) != 4 {
+		t.Errorf("%d instructions were expected, %d returned", 4, len(list))
+		return
+	}
+
+	if !list[2].IsAssignment() || !list[2].Assignment().Value().IsLoop() {
+		t.Errorf("the instruction (index: %d) was expected to contain a loop assignment", 2)
+		return
+	}
+
+	forLoop := list[2].Assignment().Value().Loop()
+	if string(forLoop.Variable()) != "items" {
+		t.Errorf("the loop's variable was expected to be '%s', '%s' returned", "items", forLoop.Variable())
+		return
+	}
+
+	if !forLoop.HasItem() || string(forLoop.Item()) != "item" {
+		t.Errorf("the loop's item was expected to be '%s'", "item")
+		return
+	}
+
+	if !forLoop.HasInstructions() || len(forLoop.Instructions().List()) != 2 {
+		t.Errorf("the loop was expected to contain %d instructions", 2)
+		return
+	}
+
+	if !list[3].IsLoop() {
+		t.Errorf("the instruction (index: %d) was expected to contain a Loop", 3)
+		return
+	}
+
+	whileLoop := list[3].Loop()
+	if string(whileLoop.Variable()) != "isRunning" {
+		t.Errorf("the loop's variable was expected to be '%s', '%s' returned", "isRunning", whileLoop.Variable())
+		return
+	}
+
+	if !whileLoop.HasMaximum() || *whileLoop.Maximum() != 10 {
+		t.Errorf("the loop's maximum was expected to be %d", 10)
+		return
+	}
+
+	if whileLoop.HasInstructions() {
+		t.Errorf("the loop was expected to NOT contain instructions")
+		return
+	}
+}
+
+func TestQuery_withImport_Success(t *testing.T) {
+	grammarIns := grammars.NewInstructionsGrammar()
+	queryIns := NewQuery()
+
+	grammarApp := grammar_application.NewApplication()
+	queryApp := query_application.NewApplication()
+
+	script := `
+		import "scripts/my lib.rodan" as $lib;;
+	`
+	treeIns, err := grammarApp.Execute(grammarIns, []byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	instructionsIns, isValid, _, err := queryApp.Execute(queryIns, treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !isValid {
+		t.Errorf("the selection was expected to be valid")
+		return
+	}
+
+	list := instructionsIns.(instructions.Instructions).List()
+	if len(list) != 1 || !list[0].IsImport() {
+		t.Errorf("the instructions were expected to contain an Import")
+		return
+	}
+
+	importIns := list[0].Import()
+	if string(importIns.Path()) != "scripts/my lib.rodan" {
+		t.Errorf("the path was expected to be '%s', '%s' returned", "scripts/my lib.rodan", importIns.Path())
+		return
+	}
+
+	if string(importIns.Variable()) != "lib" {
+		t.Errorf("the variable was expected to be '%s', '%s' returned", "lib", importIns.Variable())
+		return
+	}
+}
+
+func TestQuery_withTry_Success(t *testing.T) {
+	grammarIns := grammars.NewInstructionsGrammar()
+	queryIns := NewQuery()
+
+	grammarApp := grammar_application.NewApplication()
+	queryApp := query_application.NewApplication()
+
+	script := `
+		try {
+			execute $myApp;;
+		} catch $err {
+			fail $err;;
+		};;
+		try {} catch $other {};;
+		fail "invalid";;
+	`
+	treeIns, err := grammarApp.Execute(grammarIns, []byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	instructionsIns, isValid, _, err := queryApp.Execute(queryIns, treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !isValid {
+		t.Errorf("the selection was expected to be valid")
+		return
+	}
+
+	list := instructionsIns.(instructions.Instructions).List()
+	if len(list) != 4 {
+		t.Errorf("%d instructions were expected, %d returned", 4, len(list))
+		return
+	}
+
+	if !list[0].IsTry() {
+		t.Errorf("the instruction (index: 0) was expected to contain a Try")
+		return
+	}
+
+	tryIns := list[0].Try()
+	if string(tryIns.Variable()) != "err" {
+		t.Errorf("the variable was expected to be '%s', '%s' returned", "err", tryIns.Variable())
+		return
+	}
+
+	if !tryIns.HasInstructions() || !tryIns.Instructions().List()[0].IsExecution() {
+		t.Errorf("the try was expected to contain an execution")
+		return
+	}
+
+	if !tryIns.HasCatch() || string(tryIns.Catch().List()[0].Fail()) != "err" {
+		t.Errorf("the catch branch was expected to fail with the variable '%s'", "err")
+		return
+	}
+
+	emptyIns := list[1].Try()
+	if emptyIns.HasInstructions() || emptyIns.HasCatch() || string(emptyIns.Variable()) != "other" {
+		t.Errorf("the try (index: 1) was expected to only contain the variable '%s'", "other")
+		return
+	}
+
+	// the literal of a fail is assigned to a hidden variable before failing with it:
+	if !list[2].IsAssignment() || !list[2].Assignment().Value().IsLiteral() {
+		t.Errorf("the instruction (index: 2) was expected to assign the literal")
+		return
+	}
+
+	if !list[3].IsFail() || string(list[3].Fail()) != string(list[2].Assignment().Variable()) {
+		t.Errorf("the instruction (index: 3) was expected to fail with the assigned literal")
+		return
+	}
+}
+
+func TestQuery_withCalls_Success(t *testing.T) {
+	grammarIns := grammars.NewInstructionsGrammar()
+	queryIns := NewQuery()
+
+	grammarApp := grammar_application.NewApplication()
+	queryApp := query_application.NewApplication()
+
+	script := `
+		$output = @container($input, _, @list($other));;
+		@line($output);;
+	`
+	treeIns, err := grammarApp.Execute(grammarIns, []byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	instructionsIns, isValid, _, err := queryApp.Execute(queryIns, treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !isValid {
+		t.Errorf("the selection was expected to be valid")
+		return
+	}
+
+	list := instructionsIns.(instructions.Instructions).List()
+	if len(list) != 10 {
+		t.Errorf("%d instructions were expected, %d returned", 10, len(list))
+		return
+	}
+
+	if !list[0].IsApplication() || string(list[0].Application().Module()) != "container" {
+		t.Errorf("the instruction (index: %d) was expected to declare a container application", 0)
+		return
+	}
+
+	if !list[1].IsApplication() || string(list[1].Application().Module()) != "list" {
+		t.Errorf("the instruction (index: %d) was expected to declare a list application", 1)
+		return
+	}
+
+	containerApp := list[0].Application().Name()
+	listApp := list[1].Application().Name()
+	if string(containerApp) == "container" || string(listApp) == "list" {
+		t.Errorf("the declared applications were expected to be unique to their call")
+		return
+	}
+
+	if !list[3].IsAssignment() || !list[3].Assignment().Value().IsExecution() {
+		t.Errorf("the instruction (index: %d) was expected to assign the execution of the nested call", 3)
+		return
+	}
+
+	nestedOutput := list[3].Assignment().Variable()
+	attachments := []struct {
+		index       int
+		current     string
+		target      uint
+		application []byte
+	}{
+		{index: 2, current: "other", target: 0, application: listApp},
+		{index: 4, current: "input", target: 0, application: containerApp},
+		{index: 5, current: string(nestedOutput), target: 2, application: containerApp},
+	}
+
+	for _, oneAttachment := range attachments {
+		ins := list[oneAttachment.index]
+		if !ins.IsAttachment() {
+			t.Errorf("the instruction (index: %d) was expected to be an attachment", oneAttachment.index)
+			return
+		}
+
+		variable := ins.Attachment().Variable()
+		if string(variable.Current()) != oneAttachment.current || variable.Target() != oneAttachment.target {
+			t.Errorf("the attachment (index: %d) was expected to attach '%s' at %d, '%s' at %d returned", oneAttachment.index, oneAttachment.current, oneAttachment.target, variable.Current(), variable.Target())
+			return
+		}
+
+		if string(ins.Attachment().Application()) != string(oneAttachment.application) {
+			t.Errorf("the attachment (index: %d) was expected to target the application '%s', '%s' returned", oneAttachment.index, oneAttachment.application, ins.Attachment().Application())
+			return
+		}
+	}
+
+	if !list[6].IsAssignment() || string(list[6].Assignment().Variable()) != "output" {
+		t.Errorf("the instruction (index: %d) was expected to assign the output variable", 6)
+		return
+	}
+
+	if !list[9].IsExecution() || string(list[9].Execution()) != string(list[7].Application().Name()) {
+		t.Errorf("the instruction (index: %d) was expected to execute the line application", 9)
+		return
+	}
+}
+
+func TestQuery_withLiterals_Success(t *testing.T) {
+	grammarIns := grammars.NewInstructionsGrammar()
+	queryIns := NewQuery()
+
+	grammarApp := grammar_application.NewApplication()
+	queryApp := query_application.NewApplication()
+
+	script := `
+		$unsigned = 42;;
+		$signed = -3;;
+		$float = 2.5;;
+		$isTrue = true;;
+		$isFalse = false;;
+		$text = "a\tb \"quoted\" ;; \x41";;
+		$empty = "";;
+		$bytes = 0x00ff;;
+		$list = [1, ["nested"], []];;
+		$constant = true story;;
+	`
+	treeIns, err := grammarApp.Execute(grammarIns, []byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	instructionsIns, isValid, _, err := queryApp.Execute(queryIns, treeIns)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !isValid {
+		t.Errorf("the selection was expected to be valid")
+		return
+	}
+
+	expected := []interface{}{
+		uint(42),
+		-3,
+		2.5,
+		true,
+		false,
+		[]byte("a\tb \"quoted\" ;; A"),
+		[]byte{},
+		[]byte{0x00, 0xff},
+		[]interface{}{
+			uint(1),
+			[]interface{}{
+				[]byte("nested"),
+			},
+			[]interface{}{},
+		},
+	}
+
+	list := instructionsIns.(instructions.Instructions).List()
+	if len(list) != len(expected)+1 {
+		t.Errorf("%d instructions were expected, %d returned", len(expected)+1, len(list))
+		return
+	}
+
+	for idx, oneExpected := range expected {
+		if !list[idx].IsAssignment() || !list[idx].Assignment().Value().IsLiteral() {
+			t.Errorf("the instruction (index: %d) was expected to assign a literal", idx)
+			return
+		}
+
+		literal := list[idx].Assignment().Value().Literal()
+		if !reflect.DeepEqual(literal, oneExpected) {
+			t.Errorf("the literal (index: %d) was expected to be %v (%T), %v (%T) returned", idx, oneExpected, oneExpected, literal, literal)
+			return
+		}
+	}
+
+	last := list[len(expected)].Assignment().Value()
+	if !last.IsConstant() || string(last.Constant()) != " true story" {
+		t.Errorf("the last instruction was expected to assign a constant")
+		return
+	}
+}
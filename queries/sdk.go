@@ -28,6 +28,10 @@ func NewQuery() queries.Query {
 	instructionAssignmentBuilder := instructions.NewAssignmentBuilder()
 	instructionValueBuilder := instructions.NewValueBuilder()
 	instructionModuleBuilder := modules.NewBuilder()
+	instructionConditionBuilder := instructions.NewConditionBuilder()
+	instructionLoopBuilder := instructions.NewLoopBuilder()
+	instructionImportBuilder := instructions.NewImportBuilder()
+	instructionTryBuilder := instructions.NewTryBuilder()
 	queryIns := createQuery(
 		builder,
 		queryFnBuilder,
@@ -46,6 +50,10 @@ func NewQuery() queries.Query {
 		instructionAssignmentBuilder,
 		instructionValueBuilder,
 		instructionModuleBuilder,
+		instructionConditionBuilder,
+		instructionLoopBuilder,
+		instructionImportBuilder,
+		instructionTryBuilder,
 	)
 
 	ins, err := queryIns.Execute()
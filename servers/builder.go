@@ -0,0 +1,47 @@
+package servers
+
+type builder struct {
+	inputs map[uint]uint
+	names  map[uint]string
+}
+
+func createBuilder() Builder {
+	out := builder{
+		inputs: nil,
+		names:  nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *builder) Create() Builder {
+	return createBuilder()
+}
+
+// WithInputs adds the amount of inputs read by the modules, by module index, to the builder
+func (app *builder) WithInputs(inputs map[uint]uint) Builder {
+	app.inputs = inputs
+	return app
+}
+
+// WithNames adds the name of the modules, by module index, to the builder
+func (app *builder) WithNames(names map[uint]string) Builder {
+	app.names = names
+	return app
+}
+
+// Now builds a new Server instance
+func (app *builder) Now() (Server, error) {
+	inputs := map[uint]uint{}
+	for index, amount := range app.inputs {
+		inputs[index] = amount
+	}
+
+	names := map[uint]string{}
+	for index, name := range app.names {
+		names[index] = name
+	}
+
+	return createDefaultServer(inputs, names)
+}
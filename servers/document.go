@@ -0,0 +1,212 @@
+package servers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/steve-care-software/rodan/linters"
+)
+
+// document represents an opened document, along with what is known about its text
+type document struct {
+	uri          string
+	text         []byte
+	isGrammar    bool
+	references   []reference
+	instructions []span
+	modules      map[string]uint
+	diagnostics  []diagnostic
+}
+
+func createDocument(uri string, text []byte) *document {
+	out := document{
+		uri:          uri,
+		text:         text,
+		isGrammar:    strings.HasSuffix(uri, GrammarExtension),
+		references:   []reference{},
+		instructions: []span{},
+		modules:      map[string]uint{},
+		diagnostics:  []diagnostic{},
+	}
+
+	return &out
+}
+
+func (obj *document) index(indexer *indexer) {
+	obj.references = indexer.references
+	obj.instructions = indexer.instructions
+	obj.modules = indexer.modules
+}
+
+func (obj *document) report(severity uint, code string, message string, current span) {
+	obj.diagnostics = append(obj.diagnostics, diagnostic{
+		Range:    obj.textRange(current),
+		Severity: severity,
+		Code:     code,
+		Source:   diagnosticSource,
+		Message:  message,
+	})
+}
+
+// reportLint reports a diagnostic of the linter on the name it is about, or on its whole instruction if the name is not written in it
+func (obj *document) reportLint(lint linters.Diagnostic) {
+	if int(lint.Instruction) >= len(obj.instructions) {
+		obj.report(severityWarning, lint.Rule, lint.Message, span{})
+		return
+	}
+
+	instruction := obj.instructions[lint.Instruction]
+	name := string(lint.Name)
+	written := variableReferencePrefix + name
+	if lint.Rule == linters.RuleUndefinedModule {
+		written = moduleReferencePrefix + name
+	}
+
+	// the applications added by the query are named after the module they call:
+	if index := strings.Index(name, hiddenNameCharacter); index >= 0 {
+		written = moduleReferencePrefix + name[:index]
+	}
+
+	for _, oneReference := range obj.references {
+		if oneReference.content != written {
+			continue
+		}
+
+		if oneReference.span.start >= instruction.start && oneReference.span.end <= instruction.end {
+			obj.report(severityWarning, lint.Rule, lint.Message, oneReference.span)
+			return
+		}
+	}
+
+	obj.report(severityWarning, lint.Rule, lint.Message, instruction)
+}
+
+// reportUndeclaredTokens reports the token names of a grammar that are never declared
+func (obj *document) reportUndeclaredTokens() {
+	for _, oneReference := range obj.references {
+		if oneReference.isDefinition || obj.definition(oneReference.content) != nil {
+			continue
+		}
+
+		str := fmt.Sprintf("the token (name: %s) is never declared", oneReference.content)
+		obj.report(severityWarning, "undeclared-token", str, oneReference.span)
+	}
+}
+
+// reference returns the reference written at the offset, if any
+func (obj *document) reference(offset int) *reference {
+	for idx, oneReference := range obj.references {
+		if offset >= oneReference.span.start && offset <= oneReference.span.end {
+			return &obj.references[idx]
+		}
+	}
+
+	return nil
+}
+
+// definition returns the first reference declaring the content, if any
+func (obj *document) definition(content string) *reference {
+	var found *reference
+	for idx, oneReference := range obj.references {
+		if !oneReference.isDefinition || oneReference.content != content {
+			continue
+		}
+
+		if found == nil || oneReference.span.start < found.span.start {
+			found = &obj.references[idx]
+		}
+	}
+
+	return found
+}
+
+// contents returns the distinct contents of the references, sorted
+func (obj *document) contents(isDefinitionOnly bool) []string {
+	found := map[string]bool{}
+	for _, oneReference := range obj.references {
+		if isDefinitionOnly && !oneReference.isDefinition {
+			continue
+		}
+
+		found[oneReference.content] = true
+	}
+
+	out := []string{}
+	for oneContent := range found {
+		out = append(out, oneContent)
+	}
+
+	sort.Strings(out)
+	return out
+}
+
+func (obj *document) textRange(current span) textRange {
+	return textRange{
+		Start: obj.position(current.start),
+		End:   obj.position(current.end),
+	}
+}
+
+// position converts an offset to a line and a character, counted in UTF-16 code units as the protocol requires
+func (obj *document) position(offset int) position {
+	if offset > len(obj.text) {
+		offset = len(obj.text)
+	}
+
+	line := uint(0)
+	character := uint(0)
+	for idx := 0; idx < offset; {
+		value, size := utf8.DecodeRune(obj.text[idx:])
+		idx += size
+		if value == '\n' {
+			line++
+			character = 0
+			continue
+		}
+
+		character += utf16Length(value)
+	}
+
+	return position{
+		Line:      line,
+		Character: character,
+	}
+}
+
+// offset converts a line and a character, counted in UTF-16 code units, to an offset
+func (obj *document) offset(current position) int {
+	line := uint(0)
+	character := uint(0)
+	for idx := 0; idx < len(obj.text); {
+		if line == current.Line && character >= current.Character {
+			return idx
+		}
+
+		value, size := utf8.DecodeRune(obj.text[idx:])
+		if value == '\n' {
+			if line == current.Line {
+				return idx
+			}
+
+			line++
+			character = 0
+			idx += size
+			continue
+		}
+
+		character += utf16Length(value)
+		idx += size
+	}
+
+	return len(obj.text)
+}
+
+func utf16Length(value rune) uint {
+	if value >= 0x10000 {
+		return 2
+	}
+
+	return 1
+}
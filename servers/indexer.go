@@ -0,0 +1,165 @@
+package servers
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/steve-care-software/ast/domain/trees"
+)
+
+// span represents the offsets of a token in the text of a document, channels excluded
+type span struct {
+	start int
+	end   int
+}
+
+// reference represents a variable, module or token name written in a document
+type reference struct {
+	content      string
+	span         span
+	isDefinition bool
+}
+
+// indexer walks a tree, keeping track of the offset of its tokens in the text it was lexed from
+type indexer struct {
+	isGrammar    bool
+	offset       int
+	references   []reference
+	instructions []span
+	modules      map[string]uint
+}
+
+func createIndexer(isGrammar bool) *indexer {
+	out := indexer{
+		isGrammar:    isGrammar,
+		offset:       0,
+		references:   []reference{},
+		instructions: []span{},
+		modules:      map[string]uint{},
+	}
+
+	return &out
+}
+
+func (app *indexer) tree(tree trees.Tree, parent string, ordinal uint) span {
+	name := tree.Grammar().Name()
+
+	// the instructions are indexed in the order they are written, so before the instructions nested in them:
+	instructionIndex := -1
+	if !app.isGrammar && name == scriptInstructionTokenName {
+		instructionIndex = len(app.instructions)
+		app.instructions = append(app.instructions, span{})
+	}
+
+	start := -1
+	end := -1
+	if tree.Block().HasSuccessful() {
+		ordinals := map[string]uint{}
+		elements := tree.Block().Successful().Elements().List()
+		for _, oneElement := range elements {
+			contents := oneElement.Contents().List()
+			for _, oneContent := range contents {
+				if oneContent.IsTree() {
+					child := oneContent.Tree()
+					childName := child.Grammar().Name()
+					childSpan := app.tree(child, name, ordinals[childName])
+					ordinals[childName]++
+					if childSpan.end > childSpan.start {
+						if start < 0 {
+							start = childSpan.start
+						}
+
+						end = childSpan.end
+					}
+
+					continue
+				}
+
+				value := oneContent.Value()
+				if value.HasPrefix() {
+					app.offset += len(value.Prefix().Bytes(true))
+				}
+
+				if start < 0 {
+					start = app.offset
+				}
+
+				app.offset++
+				end = app.offset
+			}
+		}
+	}
+
+	// the suffixes of the nested tokens are excluded, as the suffix of the token:
+	if start < 0 {
+		start = app.offset
+		end = app.offset
+	}
+
+	if tree.HasSuffix() {
+		app.offset += len(tree.Suffix().Bytes(true))
+	}
+
+	current := span{
+		start: start,
+		end:   end,
+	}
+
+	if instructionIndex >= 0 {
+		app.instructions[instructionIndex] = current
+	}
+
+	app.record(tree, name, parent, ordinal, current)
+	return current
+}
+
+func (app *indexer) record(tree trees.Tree, name string, parent string, ordinal uint, current span) {
+	if app.isGrammar {
+		if name == grammarVariableTokenName {
+			app.references = append(app.references, reference{
+				content:      string(tree.Bytes(false)),
+				span:         current,
+				isDefinition: ordinal == 0 && grammarDefinitions[parent],
+			})
+		}
+
+		return
+	}
+
+	if name == scriptVariableTokenName {
+		app.references = append(app.references, reference{
+			content:      string(tree.Bytes(false)),
+			span:         current,
+			isDefinition: ordinal == 0 && scriptDefinitions[parent],
+		})
+
+		return
+	}
+
+	if name == scriptModuleTokenName {
+		app.references = append(app.references, reference{
+			content:      string(tree.Bytes(false)),
+			span:         current,
+			isDefinition: parent == scriptModuleDeclarationName,
+		})
+
+		return
+	}
+
+	if name == scriptModuleDeclarationName {
+		// the declaration is lexed without its channels as the keyword, the module reference, the separator then the index:
+		content := string(tree.Bytes(false))
+		referenceIndex := strings.Index(content, moduleReferencePrefix)
+		separatorIndex := strings.LastIndex(content, moduleDeclarationIndexSeparator)
+		if referenceIndex < 0 || separatorIndex < referenceIndex {
+			return
+		}
+
+		index, err := strconv.ParseUint(content[separatorIndex+1:], 10, 64)
+		if err != nil {
+			return
+		}
+
+		app.modules[content[referenceIndex:separatorIndex]] = uint(index)
+	}
+}
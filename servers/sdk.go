@@ -0,0 +1,113 @@
+package servers
+
+import (
+	"io"
+
+	ast_applications "github.com/steve-care-software/ast/applications"
+	query_applications "github.com/steve-care-software/query/applications"
+	rodan_grammars "github.com/steve-care-software/rodan/grammars"
+	"github.com/steve-care-software/rodan/linters"
+	"github.com/steve-care-software/rodan/queries"
+)
+
+// GrammarExtension represents the extension of the documents containing a grammar, every other document containing instructions
+const GrammarExtension = ".grammar"
+
+const jsonRPCVersion = "2.0"
+const contentLengthHeader = "Content-Length"
+const maxContentLength = 64 * 1024 * 1024
+const diagnosticSource = "rodan"
+const hiddenNameCharacter = "#"
+const variableReferencePrefix = "$"
+const moduleReferencePrefix = "@"
+
+const (
+	errorCodeParse          = -32700
+	errorCodeInvalidRequest = -32600
+	errorCodeMethodNotFound = -32601
+	errorCodeInvalidParams  = -32602
+)
+
+const (
+	severityError   = 1
+	severityWarning = 2
+)
+
+const (
+	completionKindVariable = 6
+	completionKindClass    = 7
+	completionKindModule   = 9
+)
+
+const textDocumentSyncFull = 1
+
+const (
+	scriptInstructionTokenName      = "instruction"
+	scriptVariableTokenName         = "variableReference"
+	scriptModuleTokenName           = "moduleReference"
+	scriptModuleDeclarationName     = "moduleDeclaration"
+	grammarVariableTokenName        = "variableName"
+	moduleDeclarationIndexSeparator = ":"
+)
+
+// scriptDefinitions contains the tokens whose first variable reference declares the variable
+var scriptDefinitions = map[string]bool{
+	"inputParameter":         true,
+	"outputParameter":        true,
+	"variableAssignment":     true,
+	"executionAssignment":    true,
+	"instructionsAssignment": true,
+	"loopAssignment":         true,
+	"callAssignment":         true,
+	"literalAssignment":      true,
+	"constantAssignment":     true,
+	"applicationDeclaration": true,
+	"forLoop":                true,
+	"try":                    true,
+	"import":                 true,
+}
+
+// grammarDefinitions contains the tokens whose first variable name declares the token
+var grammarDefinitions = map[string]bool{
+	"valueAssignment":         true,
+	"composeAssignment":       true,
+	"everythingAssignment":    true,
+	"tokenAssignment":         true,
+	"externalTokenAssignment": true,
+}
+
+// NewBuilder creates a new language server builder
+func NewBuilder() Builder {
+	return createBuilder()
+}
+
+// Builder represents a language server builder
+type Builder interface {
+	Create() Builder
+	WithInputs(inputs map[uint]uint) Builder
+	WithNames(names map[uint]string) Builder
+	Now() (Server, error)
+}
+
+// Server represents a language server speaking JSON-RPC
+type Server interface {
+	Serve(reader io.Reader, writer io.Writer) error
+}
+
+func createDefaultServer(inputs map[uint]uint, names map[uint]string) (Server, error) {
+	linter, err := linters.NewBuilder().Create().WithInputs(inputs).Now()
+	if err != nil {
+		return nil, err
+	}
+
+	return createServer(
+		ast_applications.NewApplication(),
+		query_applications.NewApplication(),
+		rodan_grammars.NewInstructionsGrammar(),
+		rodan_grammars.NewGrammar(),
+		queries.NewQuery(),
+		linter,
+		inputs,
+		names,
+	), nil
+}
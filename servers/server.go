@@ -0,0 +1,484 @@
+package servers
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	ast_applications "github.com/steve-care-software/ast/applications"
+	"github.com/steve-care-software/ast/domain/grammars"
+	"github.com/steve-care-software/ast/domain/trees"
+	"github.com/steve-care-software/interpreter/domain/instructions"
+	query_applications "github.com/steve-care-software/query/applications"
+	"github.com/steve-care-software/query/domain/queries"
+	"github.com/steve-care-software/rodan/linters"
+)
+
+type server struct {
+	astApplication     ast_applications.Application
+	queryApplication   query_applications.Application
+	instructionGrammar grammars.Grammar
+	grammarGrammar     grammars.Grammar
+	query              queries.Query
+	linter             linters.Linter
+	inputs             map[uint]uint
+	names              map[uint]string
+}
+
+func createServer(
+	astApplication ast_applications.Application,
+	queryApplication query_applications.Application,
+	instructionGrammar grammars.Grammar,
+	grammarGrammar grammars.Grammar,
+	query queries.Query,
+	linter linters.Linter,
+	inputs map[uint]uint,
+	names map[uint]string,
+) Server {
+	out := server{
+		astApplication:     astApplication,
+		queryApplication:   queryApplication,
+		instructionGrammar: instructionGrammar,
+		grammarGrammar:     grammarGrammar,
+		query:              query,
+		linter:             linter,
+		inputs:             inputs,
+		names:              names,
+	}
+
+	return &out
+}
+
+// Serve reads the messages of a client until it exits or closes the reader, writing the responses and notifications to the writer
+func (app *server) Serve(reader io.Reader, writer io.Writer) error {
+	session := session{
+		server:    app,
+		reader:    bufio.NewReader(reader),
+		writer:    writer,
+		documents: map[string]*document{},
+	}
+
+	return session.run()
+}
+
+// session represents the state of a connection with a client
+type session struct {
+	server     *server
+	reader     *bufio.Reader
+	writer     io.Writer
+	documents  map[string]*document
+	isShutdown bool
+}
+
+func (app *session) run() error {
+	for {
+		content, err := app.read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		msg := message{}
+		err = json.Unmarshal(content, &msg)
+		if err != nil {
+			err = app.fail(nil, errorCodeParse, err.Error())
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if msg.Method == "exit" {
+			return nil
+		}
+
+		err = app.dispatch(msg)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// dispatch handles a request or a notification, ignoring the responses and the unknown notifications
+func (app *session) dispatch(msg message) error {
+	if app.isShutdown && msg.ID != nil {
+		return app.fail(msg.ID, errorCodeInvalidRequest, "the server is shut down")
+	}
+
+	var result interface{}
+	var err error
+	switch msg.Method {
+	case "":
+		return nil
+	case "initialize":
+		result = app.initialize()
+	case "initialized":
+		return nil
+	case "shutdown":
+		app.isShutdown = true
+	case "textDocument/didOpen":
+		params := didOpenParams{}
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return app.fail(msg.ID, errorCodeInvalidParams, err.Error())
+		}
+
+		return app.open(params.TextDocument.URI, []byte(params.TextDocument.Text))
+	case "textDocument/didChange":
+		params := didChangeParams{}
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return app.fail(msg.ID, errorCodeInvalidParams, err.Error())
+		}
+
+		// the changes are synchronized as the full text of the document, so only the last one matters:
+		if len(params.ContentChanges) <= 0 {
+			return nil
+		}
+
+		last := params.ContentChanges[len(params.ContentChanges)-1]
+		return app.open(params.TextDocument.URI, []byte(last.Text))
+	case "textDocument/didClose":
+		params := didCloseParams{}
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return app.fail(msg.ID, errorCodeInvalidParams, err.Error())
+		}
+
+		delete(app.documents, params.TextDocument.URI)
+		return app.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+			URI:         params.TextDocument.URI,
+			Diagnostics: []diagnostic{},
+		})
+	case "textDocument/definition":
+		result, err = app.withPosition(msg, app.definition)
+	case "textDocument/hover":
+		result, err = app.withPosition(msg, app.hover)
+	case "textDocument/completion":
+		result, err = app.withPosition(msg, app.completion)
+	default:
+		if msg.ID == nil {
+			return nil
+		}
+
+		str := fmt.Sprintf("the method (%s) is not supported", msg.Method)
+		return app.fail(msg.ID, errorCodeMethodNotFound, str)
+	}
+
+	if err != nil {
+		return app.fail(msg.ID, errorCodeInvalidParams, err.Error())
+	}
+
+	if msg.ID == nil {
+		return nil
+	}
+
+	return app.write(response{
+		JSONRPC: jsonRPCVersion,
+		ID:      msg.ID,
+		Result:  result,
+	})
+}
+
+func (app *session) initialize() initializeResult {
+	return initializeResult{
+		Capabilities: serverCapabilities{
+			TextDocumentSync:   textDocumentSyncFull,
+			DefinitionProvider: true,
+			HoverProvider:      true,
+			CompletionProvider: completionOptions{
+				TriggerCharacters: []string{
+					variableReferencePrefix,
+					moduleReferencePrefix,
+				},
+			},
+		},
+		ServerInfo: serverInfo{
+			Name: diagnosticSource,
+		},
+	}
+}
+
+func (app *session) withPosition(msg message, fn func(doc *document, offset int) interface{}) (interface{}, error) {
+	params := textDocumentPositionParams{}
+	err := json.Unmarshal(msg.Params, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, ok := app.documents[params.TextDocument.URI]
+	if !ok {
+		str := fmt.Sprintf("the document (uri: %s) is not opened", params.TextDocument.URI)
+		return nil, errors.New(str)
+	}
+
+	return fn(doc, doc.offset(params.Position)), nil
+}
+
+func (app *session) open(uri string, text []byte) error {
+	doc := app.server.analyze(uri, text)
+	app.documents[uri] = doc
+	return app.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: doc.diagnostics,
+	})
+}
+
+func (app *session) definition(doc *document, offset int) interface{} {
+	current := doc.reference(offset)
+	if current == nil {
+		return nil
+	}
+
+	found := doc.definition(current.content)
+	if found == nil {
+		return nil
+	}
+
+	return location{
+		URI:   doc.uri,
+		Range: doc.textRange(found.span),
+	}
+}
+
+func (app *session) hover(doc *document, offset int) interface{} {
+	current := doc.reference(offset)
+	if current == nil || doc.isGrammar || !strings.HasPrefix(current.content, moduleReferencePrefix) {
+		return nil
+	}
+
+	index, ok := doc.modules[current.content]
+	if !ok {
+		return nil
+	}
+
+	return hover{
+		Contents: markupContent{
+			Kind:  "plaintext",
+			Value: app.server.describe(index),
+		},
+		Range: doc.textRange(current.span),
+	}
+}
+
+func (app *session) completion(doc *document, offset int) interface{} {
+	items := []completionItem{}
+	if doc.isGrammar {
+		for _, oneName := range doc.contents(true) {
+			items = append(items, completionItem{
+				Label: oneName,
+				Kind:  completionKindClass,
+			})
+		}
+
+		return completionList{
+			Items: items,
+		}
+	}
+
+	labels := map[string]bool{}
+	for _, oneContent := range doc.contents(false) {
+		if !strings.HasPrefix(oneContent, variableReferencePrefix) {
+			continue
+		}
+
+		labels[oneContent] = true
+		items = append(items, completionItem{
+			Label: oneContent,
+			Kind:  completionKindVariable,
+		})
+	}
+
+	// the declared modules are completed first, then the modules that could be declared:
+	declared := []string{}
+	for oneName := range doc.modules {
+		declared = append(declared, oneName)
+	}
+
+	sort.Strings(declared)
+	for _, oneName := range declared {
+		labels[oneName] = true
+		items = append(items, completionItem{
+			Label:  oneName,
+			Kind:   completionKindModule,
+			Detail: app.server.describe(doc.modules[oneName]),
+		})
+	}
+
+	indexes := []int{}
+	for index := range app.server.names {
+		indexes = append(indexes, int(index))
+	}
+
+	sort.Ints(indexes)
+	for _, oneIndex := range indexes {
+		label := moduleReferencePrefix + app.server.names[uint(oneIndex)]
+		if labels[label] {
+			continue
+		}
+
+		items = append(items, completionItem{
+			Label:  label,
+			Kind:   completionKindModule,
+			Detail: app.server.describe(uint(oneIndex)),
+		})
+	}
+
+	return completionList{
+		Items: items,
+	}
+}
+
+func (app *session) notify(method string, params interface{}) error {
+	return app.write(notification{
+		JSONRPC: jsonRPCVersion,
+		Method:  method,
+		Params:  params,
+	})
+}
+
+func (app *session) fail(id *json.RawMessage, code int, message string) error {
+	if id == nil {
+		return nil
+	}
+
+	return app.write(errorResponse{
+		JSONRPC: jsonRPCVersion,
+		ID:      id,
+		Error: responseError{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
+
+// read reads the content of the next message, framed by its headers
+func (app *session) read() ([]byte, error) {
+	length := -1
+	for {
+		line, err := app.reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			break
+		}
+
+		sections := strings.SplitN(line, ":", 2)
+		if len(sections) != 2 || !strings.EqualFold(strings.TrimSpace(sections[0]), contentLengthHeader) {
+			continue
+		}
+
+		length, err = strconv.Atoi(strings.TrimSpace(sections[1]))
+		if err != nil {
+			str := fmt.Sprintf("the header (%s) is invalid: %s", contentLengthHeader, err.Error())
+			return nil, errors.New(str)
+		}
+	}
+
+	if length < 0 {
+		str := fmt.Sprintf("the header (%s) was expected in the message", contentLengthHeader)
+		return nil, errors.New(str)
+	}
+
+	if length > maxContentLength {
+		str := fmt.Sprintf("the header (%s) contains a length (%d) that exceeds the maximum (%d)", contentLengthHeader, length, maxContentLength)
+		return nil, errors.New(str)
+	}
+
+	content := make([]byte, length)
+	_, err := io.ReadFull(app.reader, content)
+	if err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
+func (app *session) write(value interface{}) error {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(app.writer, "%s: %d\r\n\r\n%s", contentLengthHeader, len(content), content)
+	return err
+}
+
+// analyze lexes, parses and lints the text of a document
+func (app *server) analyze(uri string, text []byte) *document {
+	doc := createDocument(uri, text)
+	grammar := app.instructionGrammar
+	if doc.isGrammar {
+		grammar = app.grammarGrammar
+	}
+
+	tree, err := app.astApplication.Execute(grammar, text)
+	if err != nil {
+		doc.report(severityError, "", err.Error(), span{})
+		return doc
+	}
+
+	indexer := createIndexer(doc.isGrammar)
+	indexer.tree(tree, "", 0)
+	doc.index(indexer)
+	if tree.HasRemaining() {
+		remaining := tree.Remaining()
+		start := len(text) - len(remaining)
+		doc.report(severityError, "", "the document contains data that could not be lexed", span{
+			start: start,
+			end:   len(text),
+		})
+
+		return doc
+	}
+
+	if doc.isGrammar {
+		doc.reportUndeclaredTokens()
+		return doc
+	}
+
+	app.parse(doc, tree)
+	return doc
+}
+
+func (app *server) parse(doc *document, tree trees.Tree) {
+	ins, isValid, _, err := app.queryApplication.Execute(app.query, tree)
+	if err != nil {
+		doc.report(severityError, "", err.Error(), span{})
+		return
+	}
+
+	casted, ok := ins.(instructions.Instructions)
+	if !isValid || !ok {
+		doc.report(severityError, "", "the document could not be parsed into instructions", span{})
+		return
+	}
+
+	for _, oneDiagnostic := range app.linter.LintInstructions(casted) {
+		doc.reportLint(oneDiagnostic)
+	}
+}
+
+// describe describes a module, by its index
+func (app *server) describe(index uint) string {
+	name := "unknown"
+	if found, ok := app.names[index]; ok {
+		name = found
+	}
+
+	amount := "a variable amount of inputs"
+	if inputs, ok := app.inputs[index]; ok {
+		amount = fmt.Sprintf("%d input(s)", inputs)
+	}
+
+	return fmt.Sprintf("module %s (index: %d), reads %s", name, index, amount)
+}
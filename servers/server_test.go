@@ -0,0 +1,389 @@
+package servers
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+// client represents an in-process client, speaking to a server through pipes
+type client struct {
+	t             *testing.T
+	writer        io.WriteCloser
+	reader        *bufio.Reader
+	nextID        int
+	notifications []message
+	done          chan error
+}
+
+func createClient(t *testing.T) *client {
+	server, err := NewBuilder().Create().
+		WithInputs(map[uint]uint{
+			11: 1,
+		}).
+		WithNames(map[uint]string{
+			0:  "list",
+			11: "castToBool",
+		}).
+		Now()
+
+	if err != nil {
+		t.Fatalf("the error was expected to be nil, error returned: %s", err.Error())
+	}
+
+	serverReader, clientWriter := io.Pipe()
+	clientReader, serverWriter := io.Pipe()
+	out := client{
+		t:             t,
+		writer:        clientWriter,
+		reader:        bufio.NewReader(clientReader),
+		nextID:        1,
+		notifications: []message{},
+		done:          make(chan error, 1),
+	}
+
+	go func() {
+		out.done <- server.Serve(serverReader, serverWriter)
+		serverWriter.Close()
+	}()
+
+	return &out
+}
+
+func (app *client) send(value interface{}) {
+	content, err := json.Marshal(value)
+	if err != nil {
+		app.t.Fatalf("the error was expected to be nil, error returned: %s", err.Error())
+	}
+
+	_, err = fmt.Fprintf(app.writer, "Content-Length: %d\r\n\r\n%s", len(content), content)
+	if err != nil {
+		app.t.Fatalf("the error was expected to be nil, error returned: %s", err.Error())
+	}
+}
+
+func (app *client) receive() message {
+	session := session{
+		reader: app.reader,
+	}
+
+	content, err := session.read()
+	if err != nil {
+		app.t.Fatalf("the error was expected to be nil, error returned: %s", err.Error())
+	}
+
+	msg := message{}
+	err = json.Unmarshal(content, &msg)
+	if err != nil {
+		app.t.Fatalf("the error was expected to be nil, error returned: %s", err.Error())
+	}
+
+	return msg
+}
+
+func (app *client) notify(method string, params interface{}) {
+	app.send(map[string]interface{}{
+		"jsonrpc": jsonRPCVersion,
+		"method":  method,
+		"params":  params,
+	})
+}
+
+// call sends a request, then returns its response, keeping the notifications received in the meantime
+func (app *client) call(method string, params interface{}, result interface{}) *responseError {
+	id := app.nextID
+	app.nextID++
+	app.send(map[string]interface{}{
+		"jsonrpc": jsonRPCVersion,
+		"id":      id,
+		"method":  method,
+		"params":  params,
+	})
+
+	for {
+		msg := app.receive()
+		if msg.ID == nil {
+			app.notifications = append(app.notifications, msg)
+			continue
+		}
+
+		if string(*msg.ID) != fmt.Sprintf("%d", id) {
+			app.t.Fatalf("the response was expected to answer the request (id: %d), returned: %s", id, *msg.ID)
+		}
+
+		if msg.Error != nil {
+			return msg.Error
+		}
+
+		if result != nil {
+			err := json.Unmarshal(msg.Result, result)
+			if err != nil {
+				app.t.Fatalf("the error was expected to be nil, error returned: %s", err.Error())
+			}
+		}
+
+		return nil
+	}
+}
+
+// diagnostics waits for the diagnostics published for the document
+func (app *client) diagnostics(uri string) []diagnostic {
+	for {
+		var msg message
+		if len(app.notifications) > 0 {
+			msg = app.notifications[0]
+			app.notifications = app.notifications[1:]
+		} else {
+			msg = app.receive()
+		}
+
+		if msg.Method != "textDocument/publishDiagnostics" {
+			continue
+		}
+
+		params := publishDiagnosticsParams{}
+		err := json.Unmarshal(msg.Params, &params)
+		if err != nil {
+			app.t.Fatalf("the error was expected to be nil, error returned: %s", err.Error())
+		}
+
+		if params.URI == uri {
+			return params.Diagnostics
+		}
+	}
+}
+
+func (app *client) open(uri string, text string) []diagnostic {
+	app.notify("textDocument/didOpen", didOpenParams{
+		TextDocument: textDocumentItem{
+			URI:        uri,
+			LanguageID: "rodan",
+			Version:    1,
+			Text:       text,
+		},
+	})
+
+	return app.diagnostics(uri)
+}
+
+func (app *client) exit() {
+	err := app.call("shutdown", nil, nil)
+	if err != nil {
+		app.t.Errorf("the error was expected to be nil, error returned: %s", err.Message)
+	}
+
+	app.notify("exit", nil)
+	if err := <-app.done; err != nil {
+		app.t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+	}
+}
+
+func positionOf(text string, search string) position {
+	offset := strings.Index(text, search)
+	doc := createDocument("", []byte(text))
+	return doc.position(offset)
+}
+
+func textPosition(uri string, current position) textDocumentPositionParams {
+	return textDocumentPositionParams{
+		TextDocument: textDocumentIdentifier{
+			URI: uri,
+		},
+		Position: current,
+	}
+}
+
+func TestServer_withScript_Success(t *testing.T) {
+	uri := "file:///tmp/script.rodan"
+	script := strings.Join([]string{
+		"module @castToBool:11;;",
+		"-> $input;;",
+		"<- $output;;",
+		"$isValid = @castToBool($input);;",
+		"$unused = true;;",
+		"$output = $isValid;;",
+		"",
+	}, "\n")
+
+	client := createClient(t)
+	initialized := initializeResult{}
+	if err := client.call("initialize", map[string]interface{}{}, &initialized); err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Message)
+		return
+	}
+
+	if !initialized.Capabilities.DefinitionProvider || !initialized.Capabilities.HoverProvider {
+		t.Errorf("the server was expected to provide definitions and hovers")
+		return
+	}
+
+	client.notify("initialized", map[string]interface{}{})
+
+	// the unused variable is reported on its name:
+	diagnostics := client.open(uri, script)
+	if len(diagnostics) != 1 {
+		t.Errorf("1 diagnostic was expected, %d returned: %v", len(diagnostics), diagnostics)
+		return
+	}
+
+	expectedRange := textRange{
+		Start: position{Line: 4, Character: 0},
+		End:   position{Line: 4, Character: 7},
+	}
+
+	if diagnostics[0].Range != expectedRange || diagnostics[0].Code != "unused-variable" {
+		t.Errorf("the diagnostic was expected to report the unused variable at %v, returned: %v", expectedRange, diagnostics[0])
+		return
+	}
+
+	// the definition of a variable is its first assignment:
+	found := location{}
+	usage := positionOf(script, "$isValid;;")
+	if err := client.call("textDocument/definition", textPosition(uri, usage), &found); err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Message)
+		return
+	}
+
+	if found.Range.Start != (position{Line: 3, Character: 0}) {
+		t.Errorf("the definition was expected to start at %v, returned: %v", position{Line: 3, Character: 0}, found.Range.Start)
+		return
+	}
+
+	// the definition of a module is its declaration:
+	call := positionOf(script, "@castToBool(")
+	if err := client.call("textDocument/definition", textPosition(uri, call), &found); err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Message)
+		return
+	}
+
+	if found.Range.Start != (position{Line: 0, Character: 7}) {
+		t.Errorf("the definition was expected to start at %v, returned: %v", position{Line: 0, Character: 7}, found.Range.Start)
+		return
+	}
+
+	hovered := hover{}
+	if err := client.call("textDocument/hover", textPosition(uri, call), &hovered); err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Message)
+		return
+	}
+
+	expectedHover := "module castToBool (index: 11), reads 1 input(s)"
+	if hovered.Contents.Value != expectedHover {
+		t.Errorf("the hover was expected to be '%s', '%s' returned", expectedHover, hovered.Contents.Value)
+		return
+	}
+
+	completed := completionList{}
+	if err := client.call("textDocument/completion", textPosition(uri, position{Line: 6}), &completed); err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Message)
+		return
+	}
+
+	labels := []string{}
+	for _, oneItem := range completed.Items {
+		labels = append(labels, oneItem.Label)
+	}
+
+	expectedLabels := "$input,$isValid,$output,$unused,@castToBool,@list"
+	if strings.Join(labels, ",") != expectedLabels {
+		t.Errorf("the completion was expected to be '%s', '%s' returned", expectedLabels, strings.Join(labels, ","))
+		return
+	}
+
+	// the diagnostics follow the changes:
+	client.notify("textDocument/didChange", didChangeParams{
+		TextDocument: textDocumentIdentifier{
+			URI: uri,
+		},
+		ContentChanges: []contentChange{
+			{
+				Text: "<- $output;;\n$output = $missing;;\n",
+			},
+		},
+	})
+
+	diagnostics = client.diagnostics(uri)
+	if len(diagnostics) != 1 || diagnostics[0].Code != "undefined-variable" {
+		t.Errorf("the undefined variable was expected to be reported, returned: %v", diagnostics)
+		return
+	}
+
+	client.exit()
+}
+
+func TestServer_withInvalidScript_reportsError(t *testing.T) {
+	uri := "file:///tmp/invalid.rodan"
+	client := createClient(t)
+	diagnostics := client.open(uri, "<- $output;;\n~~~")
+	if len(diagnostics) != 1 || diagnostics[0].Severity != severityError {
+		t.Errorf("1 error was expected, returned: %v", diagnostics)
+		return
+	}
+
+	if diagnostics[0].Range.Start != (position{Line: 1, Character: 0}) {
+		t.Errorf("the error was expected to start at %v, returned: %v", position{Line: 1, Character: 0}, diagnostics[0].Range.Start)
+		return
+	}
+
+	client.exit()
+}
+
+func TestServer_withGrammar_Success(t *testing.T) {
+	uri := "file:///tmp/values" + GrammarExtension
+	grammar := strings.Join([]string{
+		"@myRoot;",
+		"myRoot: myValue+ myMissing",
+		"---",
+		"valid: myValue;",
+		";",
+		"myValue: 45;",
+		"",
+	}, "\n")
+
+	client := createClient(t)
+	diagnostics := client.open(uri, grammar)
+	if len(diagnostics) != 1 || diagnostics[0].Code != "undeclared-token" {
+		t.Errorf("the undeclared token was expected to be reported, returned: %v", diagnostics)
+		return
+	}
+
+	found := location{}
+	usage := positionOf(grammar, "myValue+")
+	if err := client.call("textDocument/definition", textPosition(uri, usage), &found); err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Message)
+		return
+	}
+
+	if found.Range.Start != (position{Line: 5, Character: 0}) {
+		t.Errorf("the definition was expected to start at %v, returned: %v", position{Line: 5, Character: 0}, found.Range.Start)
+		return
+	}
+
+	client.exit()
+}
+
+func TestServer_withUnknownMethod_returnsError(t *testing.T) {
+	client := createClient(t)
+	err := client.call("workspace/unknown", nil, nil)
+	if err == nil || err.Code != errorCodeMethodNotFound {
+		t.Errorf("the method was expected to not be found, returned: %v", err)
+		return
+	}
+
+	client.exit()
+}
+
+func TestServer_withTooLongContent_returnsError(t *testing.T) {
+	session := session{
+		reader: bufio.NewReader(strings.NewReader(fmt.Sprintf("Content-Length: %d\r\n\r\n", maxContentLength+1))),
+	}
+
+	_, err := session.read()
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
@@ -0,0 +1,90 @@
+package traces
+
+import (
+	"context"
+	"sync"
+
+	"github.com/steve-care-software/interpreter/applications"
+)
+
+type debugger struct {
+	mutex       sync.Mutex
+	pauseFn     PauseFn
+	breakpoints []string
+	mp          map[string]bool
+	tracer      Tracer
+	isStep      bool
+}
+
+func createDebugger(
+	pauseFn PauseFn,
+	breakpoints []string,
+	mp map[string]bool,
+	tracer Tracer,
+	isStep bool,
+) Debugger {
+	out := debugger{
+		pauseFn:     pauseFn,
+		breakpoints: breakpoints,
+		mp:          mp,
+		tracer:      tracer,
+		isStep:      isStep,
+	}
+
+	return &out
+}
+
+// Trace traces the event, then pauses if the debugger is in step mode or if the event assigns a breakpoint variable
+func (app *debugger) Trace(ctx context.Context, event applications.Event) error {
+	if app.tracer != nil {
+		err := app.tracer.Trace(ctx, event)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !app.shouldPause(event) {
+		return nil
+	}
+
+	return app.pauseFn(ctx, event)
+}
+
+// Step switches the debugger to step mode, pausing after every instruction
+func (app *debugger) Step() {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	app.isStep = true
+}
+
+// Continue switches the debugger out of step mode, pausing only on its breakpoints
+func (app *debugger) Continue() {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	app.isStep = false
+}
+
+// IsStep returns true if the debugger is in step mode, false otherwise
+func (app *debugger) IsStep() bool {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	return app.isStep
+}
+
+// Breakpoints returns the breakpoint variable names
+func (app *debugger) Breakpoints() []string {
+	return app.breakpoints
+}
+
+func (app *debugger) shouldPause(event applications.Event) bool {
+	if app.IsStep() {
+		return true
+	}
+
+	if event.Variable == nil {
+		return false
+	}
+
+	_, ok := app.mp[string(event.Variable)]
+	return ok
+}
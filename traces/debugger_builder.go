@@ -0,0 +1,78 @@
+package traces
+
+import (
+	"errors"
+	"strings"
+)
+
+type debuggerBuilder struct {
+	pauseFn     PauseFn
+	breakpoints []string
+	tracer      Tracer
+	isStep      bool
+}
+
+func createDebuggerBuilder() DebuggerBuilder {
+	out := debuggerBuilder{
+		pauseFn:     nil,
+		breakpoints: nil,
+		tracer:      nil,
+		isStep:      false,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *debuggerBuilder) Create() DebuggerBuilder {
+	return createDebuggerBuilder()
+}
+
+// WithPauseFn adds a pauseFn to the builder
+func (app *debuggerBuilder) WithPauseFn(pauseFn PauseFn) DebuggerBuilder {
+	app.pauseFn = pauseFn
+	return app
+}
+
+// WithBreakpoints add breakpoints to the builder
+func (app *debuggerBuilder) WithBreakpoints(breakpoints []string) DebuggerBuilder {
+	app.breakpoints = breakpoints
+	return app
+}
+
+// WithTracer adds a tracer, receiving every event, to the builder
+func (app *debuggerBuilder) WithTracer(tracer Tracer) DebuggerBuilder {
+	app.tracer = tracer
+	return app
+}
+
+// IsStep flags the builder as starting in step mode
+func (app *debuggerBuilder) IsStep() DebuggerBuilder {
+	app.isStep = true
+	return app
+}
+
+// Now builds a new Debugger instance
+func (app *debuggerBuilder) Now() (Debugger, error) {
+	if app.pauseFn == nil {
+		return nil, errors.New("the pauseFn is mandatory in order to build a Debugger instance")
+	}
+
+	breakpoints := []string{}
+	mp := map[string]bool{}
+	for _, oneBreakpoint := range app.breakpoints {
+		name := strings.TrimPrefix(strings.TrimSpace(oneBreakpoint), variablePrefix)
+		if name == "" {
+			return nil, errors.New("the breakpoints must contain variable names")
+		}
+
+		if _, ok := mp[name]; ok {
+			continue
+		}
+
+		breakpoints = append(breakpoints, name)
+		mp[name] = true
+	}
+
+	return createDebugger(app.pauseFn, breakpoints, mp, app.tracer, app.isStep), nil
+}
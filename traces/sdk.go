@@ -0,0 +1,47 @@
+package traces
+
+import (
+	"context"
+	"io"
+
+	"github.com/steve-care-software/interpreter/applications"
+)
+
+const variablePrefix = "$"
+
+// NewWriter creates a new tracer writing every event as a JSON line
+func NewWriter(writer io.Writer) Tracer {
+	return createWriter(writer)
+}
+
+// NewDebuggerBuilder creates a new debugger builder
+func NewDebuggerBuilder() DebuggerBuilder {
+	return createDebuggerBuilder()
+}
+
+// PauseFn is called when the debugger pauses on an event, the execution resumes when it returns and stops if it returns an error
+type PauseFn func(ctx context.Context, event applications.Event) error
+
+// Tracer represents an execution tracer
+type Tracer interface {
+	Trace(ctx context.Context, event applications.Event) error
+}
+
+// DebuggerBuilder represents a debugger builder
+type DebuggerBuilder interface {
+	Create() DebuggerBuilder
+	WithPauseFn(pauseFn PauseFn) DebuggerBuilder
+	WithBreakpoints(breakpoints []string) DebuggerBuilder
+	WithTracer(tracer Tracer) DebuggerBuilder
+	IsStep() DebuggerBuilder
+	Now() (Debugger, error)
+}
+
+// Debugger represents a step debugger, pausing after the instructions assigning its breakpoint variables, or after every instruction in step mode
+type Debugger interface {
+	Tracer
+	Step()
+	Continue()
+	IsStep() bool
+	Breakpoints() []string
+}
@@ -0,0 +1,194 @@
+package traces
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/steve-care-software/interpreter/applications"
+	"github.com/steve-care-software/interpreter/domain/programs"
+	"github.com/steve-care-software/interpreter/domain/programs/modules"
+)
+
+func TestWriter_Success(t *testing.T) {
+	buffer := bytes.NewBuffer(nil)
+	_, err := execute(NewWriter(buffer))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	if len(lines) != 2 {
+		t.Errorf("%d lines were expected, %d returned", 2, len(lines))
+		return
+	}
+
+	decoded := map[string]interface{}{}
+	err = json.Unmarshal([]byte(lines[0]), &decoded)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := map[string]interface{}{
+		"variable":    "first",
+		"application": "myApp",
+		"moduleName":  "myModule",
+		"output":      "hello",
+	}
+
+	for key, value := range expected {
+		if decoded[key] != value {
+			t.Errorf("the %s was expected to be '%v', '%v' returned", key, value, decoded[key])
+			return
+		}
+	}
+
+	input := decoded["input"].(map[string]interface{})
+	if text := input["0"].(map[string]interface{})["text"]; text != "world" {
+		t.Errorf("the input was expected to be '%s', '%v' returned", "world", text)
+		return
+	}
+}
+
+func TestDebugger_breakpoint_Success(t *testing.T) {
+	paused := []string{}
+	debugger, err := NewDebuggerBuilder().Create().WithBreakpoints([]string{"$second"}).WithPauseFn(func(ctx context.Context, event applications.Event) error {
+		paused = append(paused, string(event.Variable))
+		return nil
+	}).Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = execute(debugger)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(paused) != 1 || paused[0] != "second" {
+		t.Errorf("the debugger was expected to pause once on the second variable, paused: %v", paused)
+		return
+	}
+}
+
+func TestDebugger_step_Success(t *testing.T) {
+	amount := 0
+	var debugger Debugger
+	debugger, _ = NewDebuggerBuilder().Create().IsStep().WithPauseFn(func(ctx context.Context, event applications.Event) error {
+		amount++
+		debugger.Continue()
+		return nil
+	}).Now()
+
+	_, err := execute(debugger)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if amount != 1 {
+		t.Errorf("the debugger was expected to pause %d times, %d returned", 1, amount)
+		return
+	}
+}
+
+func execute(tracer Tracer) ([]interface{}, error) {
+	module, _ := modules.NewModuleBuilder().Create().WithIndex(0).WithFunc(func(input map[uint]interface{}) (interface{}, error) {
+		return "hello", nil
+	}).Now()
+
+	constant, _ := programs.NewValueBuilder().Create().WithConstant([]byte("world")).Now()
+	attachment, _ := programs.NewAttachmentBuilder().Create().WithValue(constant).WithLocal(0).Now()
+	attachments, _ := programs.NewAttachmentsBuilder().Create().WithList([]programs.Attachment{
+		attachment,
+	}).Now()
+
+	appIns, _ := programs.NewApplicationBuilder().Create().
+		WithIndex(0).
+		WithModule(module).
+		WithAttachments(attachments).
+		WithName([]byte("myApp")).
+		WithModuleName([]byte("myModule")).
+		Now()
+
+	value, _ := programs.NewValueBuilder().Create().WithExecution(appIns).Now()
+	list := []programs.Instruction{}
+	for _, oneVariable := range []string{"first", "second"} {
+		ins, _ := programs.NewInstructionBuilder().Create().WithValue(value).WithVariable([]byte(oneVariable)).Now()
+		list = append(list, ins)
+	}
+
+	instructions, _ := programs.NewInstructionsBuilder().Create().WithList(list).Now()
+	program, _ := programs.NewBuilder().Create().WithInstructions(instructions).Now()
+	interpreterApp := applications.NewApplication(func(name []byte) string {
+		return string(name)
+	})
+
+	ctx := applications.WithHook(context.Background(), tracer.Trace)
+	return interpreterApp.ExecuteContext(ctx, []interface{}{}, program)
+}
+
+func TestWriter_nonFiniteFloats_Success(t *testing.T) {
+	buffer := bytes.NewBuffer(nil)
+	writer := NewWriter(buffer)
+	err := writer.Trace(context.Background(), applications.Event{
+		Input: map[uint]interface{}{
+			0: math.Inf(1),
+			1: float32(math.Inf(-1)),
+		},
+		Output: math.NaN(),
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	decoded := map[string]interface{}{}
+	err = json.Unmarshal(buffer.Bytes(), &decoded)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	input := decoded["input"].(map[string]interface{})
+	if decoded["output"] != "NaN" || input["0"] != "+Inf" || input["1"] != "-Inf" {
+		t.Errorf("the non-finite floats were expected to be written as text, returned: %s", buffer.String())
+		return
+	}
+}
+
+func TestWriter_bytesAndMaps_Success(t *testing.T) {
+	buffer := bytes.NewBuffer(nil)
+	writer := NewWriter(buffer)
+	err := writer.Trace(context.Background(), applications.Event{
+		Input: map[uint]interface{}{
+			0: []byte("cafe"),
+			1: []byte{0xca, 0xfe},
+			2: "cafe",
+		},
+		Output: map[string]interface{}{
+			"name":   []byte("rodan"),
+			"values": []interface{}{uint(1), []byte{0xff}},
+		},
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := `{"depth":0,"instruction":0,"input":{"0":{"text":"cafe"},"1":{"hex":"cafe"},"2":"cafe"},"output":{"name":{"text":"rodan"},"values":[1,{"hex":"ff"}]},"durationNs":0}`
+	if strings.TrimSpace(buffer.String()) != expected {
+		t.Errorf("the event was expected to be written as '%s', '%s' returned", expected, strings.TrimSpace(buffer.String()))
+		return
+	}
+}
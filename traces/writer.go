@@ -0,0 +1,121 @@
+package traces
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/steve-care-software/interpreter/applications"
+)
+
+type event struct {
+	Depth       uint                   `json:"depth"`
+	Instruction uint                   `json:"instruction"`
+	Variable    string                 `json:"variable,omitempty"`
+	Application string                 `json:"application,omitempty"`
+	Module      *uint                  `json:"module,omitempty"`
+	ModuleName  string                 `json:"moduleName,omitempty"`
+	Input       map[string]interface{} `json:"input,omitempty"`
+	Output      interface{}            `json:"output,omitempty"`
+	Error       string                 `json:"error,omitempty"`
+	Duration    int64                  `json:"durationNs"`
+}
+
+type writer struct {
+	mutex   sync.Mutex
+	encoder *json.Encoder
+}
+
+func createWriter(
+	output io.Writer,
+) Tracer {
+	out := writer{
+		encoder: json.NewEncoder(output),
+	}
+
+	return &out
+}
+
+// Trace writes the event as a JSON line
+func (app *writer) Trace(ctx context.Context, ins applications.Event) error {
+	line := event{
+		Depth:       ins.Depth,
+		Instruction: ins.Instruction,
+		Variable:    string(ins.Variable),
+		Application: string(ins.Application),
+		Module:      ins.Module,
+		ModuleName:  string(ins.ModuleName),
+		Output:      toValue(ins.Output),
+		Duration:    ins.Duration.Nanoseconds(),
+	}
+
+	if len(ins.Input) > 0 {
+		line.Input = map[string]interface{}{}
+		for idx, oneValue := range ins.Input {
+			line.Input[strconv.Itoa(int(idx))] = toValue(oneValue)
+		}
+	}
+
+	if ins.Error != nil {
+		line.Error = ins.Error.Error()
+	}
+
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	return app.encoder.Encode(line)
+}
+
+// toValue returns the value encodable in JSON, the other types being written as their name
+func toValue(value interface{}) interface{} {
+	switch casted := value.(type) {
+	case nil, bool, string, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return casted
+	case float32:
+		return toFloatValue(float64(casted))
+	case float64:
+		return toFloatValue(casted)
+	case []byte:
+		// the bytes are tagged with their encoding, so that they are never mistaken for a string:
+		if utf8.Valid(casted) {
+			return map[string]interface{}{
+				"text": string(casted),
+			}
+		}
+
+		return map[string]interface{}{
+			"hex": fmt.Sprintf("%x", casted),
+		}
+	case []interface{}:
+		list := []interface{}{}
+		for _, oneValue := range casted {
+			list = append(list, toValue(oneValue))
+		}
+
+		return list
+	case map[string]interface{}:
+		values := map[string]interface{}{}
+		for key, oneValue := range casted {
+			values[key] = toValue(oneValue)
+		}
+
+		return values
+	case error:
+		return casted.Error()
+	}
+
+	return fmt.Sprintf("%T", value)
+}
+
+// toFloatValue returns the float, or its text when it is not finite since JSON cannot encode it
+func toFloatValue(value float64) interface{} {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+
+	return value
+}